@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"os"
 
 	multierror "github.com/hashicorp/go-multierror"
 	rpmdb "github.com/knqyf263/go-rpmdb/pkg"
@@ -17,10 +18,11 @@ func main() {
 }
 
 func run() error {
-	db, err := detectDB()
+	db, err := openDB()
 	if err != nil {
 		return err
 	}
+	defer db.Close()
 	pkgList, err := db.ListPackages()
 	if err != nil {
 		return err
@@ -33,11 +35,54 @@ func run() error {
 		pkg.DirIndexes = nil
 		pkg.DirNames = nil
 		pkg.FileSizes = nil
+		pkg.LongFileSizes = nil
 		pkg.FileDigests = nil
 		pkg.FileModes = nil
 		pkg.FileFlags = nil
 		pkg.UserNames = nil
 		pkg.GroupNames = nil
+		pkg.FileLinkTos = nil
+		pkg.FileMTimes = nil
+		pkg.FileRDevs = nil
+		pkg.FileStates = nil
+		pkg.FileVerifyFlags = nil
+		pkg.FileCaps = nil
+		pkg.FileInodes = nil
+		pkg.FileDevices = nil
+		pkg.FileLangs = nil
+		pkg.FileColors = nil
+		pkg.FileClass = nil
+		pkg.ClassDict = nil
+		pkg.FileDependsX = nil
+		pkg.FileDependsN = nil
+		pkg.DependsDict = nil
+		pkg.ChangelogTimes = nil
+		pkg.ChangelogNames = nil
+		pkg.ChangelogTexts = nil
+		pkg.FileSignatures = nil
+		pkg.VeritySignatures = nil
+		pkg.ProvideFlags = nil
+		pkg.ProvideVersions = nil
+		pkg.RequireFlags = nil
+		pkg.RequireVersions = nil
+		pkg.Conflicts = nil
+		pkg.ConflictFlags = nil
+		pkg.ConflictVersions = nil
+		pkg.Obsoletes = nil
+		pkg.ObsoleteFlags = nil
+		pkg.ObsoleteVersions = nil
+		pkg.Recommends = nil
+		pkg.RecommendFlags = nil
+		pkg.RecommendVersions = nil
+		pkg.Suggests = nil
+		pkg.SuggestFlags = nil
+		pkg.SuggestVersions = nil
+		pkg.Supplements = nil
+		pkg.SupplementFlags = nil
+		pkg.SupplementVersions = nil
+		pkg.Enhances = nil
+		pkg.EnhanceFlags = nil
+		pkg.EnhanceVersions = nil
 
 		fmt.Printf("\t%+v\n", *pkg)
 	}
@@ -46,6 +91,20 @@ func run() error {
 	return nil
 }
 
+// openDB opens the rpmdb of the root filesystem given as the first argument, or
+// looks for a database file in the current directory.
+func openDB() (*rpmdb.RpmDB, error) {
+	if len(os.Args) > 1 {
+		db, err := rpmdb.OpenRoot(os.Args[1])
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("Database: %s (%s)\n", db.Path(), db.Format())
+		return db, nil
+	}
+	return detectDB()
+}
+
 func detectDB() (*rpmdb.RpmDB, error) {
 	var result error
 	db, err := rpmdb.Open("./rpmdb.sqlite")
@@ -1,6 +1,6 @@
 module github.com/knqyf263/go-rpmdb
 
-go 1.22.0
+go 1.23.0
 
 toolchain go1.24.1
 
@@ -9,6 +9,7 @@ require (
 	github.com/hashicorp/go-multierror v1.1.1
 	github.com/stretchr/testify v1.10.0
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
+	modernc.org/sqlite v1.20.3
 )
 
 require (
@@ -26,5 +27,4 @@ require (
 	modernc.org/libc v1.22.2 // indirect
 	modernc.org/mathutil v1.5.0 // indirect
 	modernc.org/memory v1.5.0 // indirect
-	modernc.org/sqlite v1.20.3 // indirect
 )
@@ -1,8 +1,12 @@
 package bdb
 
 import (
+	"context"
 	"io"
+	"io/fs"
+	"math/bits"
 	"os"
+	"path/filepath"
 
 	dbi "github.com/knqyf263/go-rpmdb/pkg/db"
 	"golang.org/x/xerrors"
@@ -20,7 +24,9 @@ var validPageSizes = map[uint32]struct{}{
 }
 
 type BerkeleyDB struct {
-	file         *os.File
+	r            io.ReaderAt
+	closer       io.Closer
+	dir          string // where the index files are, if opened from a path
 	HashMetadata *HashMetadataPage
 }
 
@@ -30,19 +36,28 @@ func Open(path string) (*BerkeleyDB, error) {
 		return nil, err
 	}
 
-	// read just a bit in to parse at least the metadata...
-	metadataBuff := make([]byte, 512)
-	_, err = file.Read(metadataBuff)
+	db, err := OpenReaderAt(file)
 	if err != nil {
-		return nil, xerrors.Errorf("failed to read metadata: %w", err)
+		_ = file.Close()
+		return nil, err
 	}
+	db.closer = file
+	db.dir = filepath.Dir(path)
 
-	_, err = file.Seek(0, io.SeekStart)
-	if err != nil {
-		return nil, xerrors.Errorf("failed to seek db file: %w", err)
+	return db, nil
+}
+
+// OpenReaderAt reads a Berkeley DB hash database from r. Closing the returned
+// database does not close r.
+func OpenReaderAt(r io.ReaderAt) (*BerkeleyDB, error) {
+	// read just a bit in to parse at least the metadata...
+	metadataBuff := make([]byte, 512)
+	n, err := r.ReadAt(metadataBuff, 0)
+	if err != nil && (err != io.EOF || n == 0) {
+		return nil, xerrors.Errorf("failed to read metadata: %w", err)
 	}
 
-	hashMetadata, err := ParseHashMetadataPage(metadataBuff)
+	hashMetadata, err := ParseHashMetadataPage(metadataBuff[:n])
 	if err != nil {
 		return nil, err
 	}
@@ -52,44 +67,47 @@ func Open(path string) (*BerkeleyDB, error) {
 	}
 
 	return &BerkeleyDB{
-		file:         file,
+		r:            r,
 		HashMetadata: hashMetadata,
 	}, nil
 }
 
 func (db *BerkeleyDB) Close() error {
-	return db.file.Close()
+	if db.closer == nil {
+		return nil
+	}
+	return db.closer.Close()
 }
 
 func (db *BerkeleyDB) Read() <-chan dbi.Entry {
+	return db.ReadContext(context.Background())
+}
+
+func (db *BerkeleyDB) ReadContext(ctx context.Context) <-chan dbi.Entry {
 	entries := make(chan dbi.Entry)
 
 	go func() {
 		defer close(entries)
 
 		for pageNum := uint32(0); pageNum <= db.HashMetadata.LastPageNo; pageNum++ {
-			pageData, err := slice(db.file, int(db.HashMetadata.PageSize))
-			if err != nil {
-				entries <- dbi.Entry{
-					Err: err,
-				}
+			if ctx.Err() != nil {
 				return
 			}
 
-			// keep track of the start of the next page for the next iteration...
-			endOfPageOffset, err := db.file.Seek(0, io.SeekCurrent)
+			pageSize := db.HashMetadata.PageSize
+			pageData, err := slice(db.r, int64(pageNum)*int64(pageSize), int(pageSize))
 			if err != nil {
-				entries <- dbi.Entry{
+				dbi.Send(ctx, entries, dbi.Entry{
 					Err: err,
-				}
+				})
 				return
 			}
 
 			hashPageHeader, err := ParseHashPage(pageData, db.HashMetadata.Swapped)
 			if err != nil {
-				entries <- dbi.Entry{
+				dbi.Send(ctx, entries, dbi.Entry{
 					Err: err,
-				}
+				})
 				return
 			}
 
@@ -99,15 +117,20 @@ func (db *BerkeleyDB) Read() <-chan dbi.Entry {
 				continue
 			}
 
-			hashPageIndexes, err := HashPageValueIndexes(pageData, hashPageHeader.NumEntries, db.HashMetadata.Swapped)
+			offsets, err := pageOffsets(pageData, hashPageHeader.NumEntries, db.HashMetadata.Swapped)
+			if err == nil && len(offsets)%2 != 0 {
+				err = xerrors.Errorf("invalid hash index: entries should only come in pairs (%+v)", len(offsets))
+			}
 			if err != nil {
-				entries <- dbi.Entry{
+				dbi.Send(ctx, entries, dbi.Entry{
 					Err: err,
-				}
+				})
 				return
 			}
 
-			for _, hashPageIndex := range hashPageIndexes {
+			for i := 0; i < len(offsets); i += 2 {
+				hashPageIndex := offsets[i+1]
+
 				// the first byte is the page type, so we can peek at it first before parsing further...
 				valuePageType := pageData[hashPageIndex]
 
@@ -118,33 +141,167 @@ func (db *BerkeleyDB) Read() <-chan dbi.Entry {
 
 				// Traverse the page to concatenate the data that may span multiple pages.
 				valueContent, err := HashPageValueContent(
-					db.file,
+					db.r,
 					pageData,
 					hashPageIndex,
 					db.HashMetadata.PageSize,
 					db.HashMetadata.Swapped,
 				)
 
-				entries <- dbi.Entry{
-					Value: valueContent,
-					Err:   err,
+				hnum, _ := db.headerNum(pageData, offsets[i])
+				if !dbi.Send(ctx, entries, dbi.Entry{
+					HeaderNum: hnum,
+					Value:     valueContent,
+					Err:       err,
+				}) {
+					return
 				}
 
 				if err != nil {
 					return
 				}
 			}
-
-			// go back to the start of the next page for reading...
-			_, err = db.file.Seek(endOfPageOffset, io.SeekStart)
-			if err != nil {
-				entries <- dbi.Entry{
-					Err: err,
-				}
-				return
-			}
 		}
 	}()
 
 	return entries
 }
+
+// LookupContext returns the header numbers stored under key in the index file of
+// the same name next to the Packages file.
+func (db *BerkeleyDB) LookupContext(ctx context.Context, index dbi.Index, key string) ([]uint32, error) {
+	if db.dir == "" {
+		return nil, xerrors.Errorf("%s: %w", index, dbi.ErrorIndexNotFound)
+	}
+	idx, err := OpenIndex(filepath.Join(db.dir, string(index)))
+	if xerrors.Is(err, fs.ErrNotExist) {
+		return nil, xerrors.Errorf("%s: %w", index, dbi.ErrorIndexNotFound)
+	} else if err != nil {
+		return nil, xerrors.Errorf("failed to open index %s: %w", index, err)
+	}
+	defer idx.Close()
+
+	items, err := idx.LookupContext(ctx, []byte(key))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to look up %s in %s: %w", key, index, err)
+	}
+
+	var hnums []uint32
+	seen := make(map[uint32]struct{})
+	for _, item := range items {
+		if _, ok := seen[item.HeaderNum]; !ok {
+			seen[item.HeaderNum] = struct{}{}
+			hnums = append(hnums, item.HeaderNum)
+		}
+	}
+	return hnums, nil
+}
+
+// GetContext returns the header stored under the key hnum. Only the pages of the
+// hash bucket of the key are read, unless the database was created with another
+// hash function than rpm uses, in which case all the pages are scanned.
+func (db *BerkeleyDB) GetContext(ctx context.Context, hnum uint32) ([]byte, error) {
+	md := db.HashMetadata
+
+	if md.CharKeyHash != hashFunc([]byte(charKey)) {
+		for pageNum := uint32(0); pageNum <= md.LastPageNo; pageNum++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			value, _, err := db.getFromPage(pageNum, hnum)
+			if err != nil || value != nil {
+				return value, err
+			}
+		}
+		return nil, xerrors.Errorf("header %d: %w", hnum, dbi.ErrorHeaderNotFound)
+	}
+
+	key := make([]byte, 4)
+	byteOrder(md.Swapped).PutUint32(key, hnum)
+
+	// source: __ham_call_hash() and BUCKET_TO_PAGE() in hash.h
+	bucket := hashFunc(key) & md.HighMask
+	if bucket > md.MaxBucket {
+		bucket &= md.LowMask
+	}
+	log2 := bits.Len32(bucket) // __db_log2(bucket + 1)
+	if log2 >= len(md.Spares) {
+		return nil, xerrors.Errorf("invalid bucket: %d", bucket)
+	}
+
+	// follow the chain of pages of the bucket, at most every page once
+	pageNum := bucket + md.Spares[log2]
+	for n := uint32(0); pageNum != 0 && n <= md.LastPageNo; n++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if pageNum > md.LastPageNo {
+			return nil, xerrors.Errorf("invalid page number: %d", pageNum)
+		}
+		value, next, err := db.getFromPage(pageNum, hnum)
+		if err != nil || value != nil {
+			return value, err
+		}
+		pageNum = next
+	}
+	return nil, xerrors.Errorf("header %d: %w", hnum, dbi.ErrorHeaderNotFound)
+}
+
+// getFromPage returns the header stored under the key hnum on the hash page
+// pageNum, or nil and the next page of its bucket.
+func (db *BerkeleyDB) getFromPage(pageNum, hnum uint32) ([]byte, uint32, error) {
+	pageSize := db.HashMetadata.PageSize
+
+	pageData, err := slice(db.r, int64(pageNum)*int64(pageSize), int(pageSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	hashPageHeader, err := ParseHashPage(pageData, db.HashMetadata.Swapped)
+	if err != nil {
+		return nil, 0, err
+	}
+	if hashPageHeader.PageType != HashUnsortedPageType && hashPageHeader.PageType != HashPageType {
+		return nil, 0, nil
+	}
+
+	offsets, err := pageOffsets(pageData, hashPageHeader.NumEntries, db.HashMetadata.Swapped)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := 0; i+1 < len(offsets); i += 2 {
+		if key, ok := db.headerNum(pageData, offsets[i]); !ok || key != hnum {
+			continue
+		}
+		if int(offsets[i+1]) >= len(pageData) || pageData[offsets[i+1]] != HashOffIndexPageType {
+			continue
+		}
+		value, err := HashPageValueContent(db.r, pageData, offsets[i+1], pageSize, db.HashMetadata.Swapped)
+		return value, 0, err
+	}
+	return nil, hashPageHeader.NextPageNo, nil
+}
+
+// headerNum returns the header number stored in the key at offset of a hash page,
+// an H_KEYDATA item holding a 4-byte integer.
+func (db *BerkeleyDB) headerNum(pageData []byte, offset uint16) (uint32, bool) {
+	if int(offset)+5 > len(pageData) || pageData[offset] != hashKeyDataType {
+		return 0, false
+	}
+	return byteOrder(db.HashMetadata.Swapped).Uint32(pageData[offset+1:]), true
+}
+
+// charKey is hashed into the metadata, telling which hash function the database
+// was created with.
+// source: CHARKEY in hash.h
+const charKey = "%$sniglet^&"
+
+// hashFunc is the default hash function of Berkeley DB, which rpm keeps.
+// source: __ham_func5() in hash_func.c
+func hashFunc(key []byte) uint32 {
+	var h uint32
+	for _, c := range key {
+		h ^= uint32(c)
+		h *= 16777619
+	}
+	return h
+}
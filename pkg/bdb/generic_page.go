@@ -24,7 +24,7 @@ type GenericMetadataPage struct {
 	KeyCount      uint32   `struct:"uint32"`   /* 40-43: Cached key count. */
 	RecordCount   uint32   `struct:"uint32"`   /* 44-47: Cached record count. */
 	Flags         uint32   `struct:"uint32"`   /* 48-51: Flags: unique to each AM. */
-	UniqueFileID  [19]byte `struct:"[19]byte"` /* 52-71: Unique file ID. */
+	UniqueFileID  [20]byte `struct:"[20]byte"` /* 52-71: Unique file ID. */
 }
 
 func ParseGenericMetadataPage(data []byte) (*GenericMetadataPage, error) {
@@ -45,3 +45,34 @@ func (p *GenericMetadataPage) validate() error {
 
 	return nil
 }
+
+// MetadataPage is the metadata page of a database of any access method.
+type MetadataPage struct {
+	GenericMetadataPage
+	Swapped bool
+}
+
+// ParseMetadataPage parses the generic part of a hash or btree metadata page,
+// detecting the byte order of the database.
+func ParseMetadataPage(data []byte) (*MetadataPage, error) {
+	var page MetadataPage
+
+	err := binary.Read(bytes.NewReader(data), binary.LittleEndian, &page.GenericMetadataPage)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to unpack MetadataPage: %w", err)
+	}
+
+	switch page.Magic {
+	case HashMagicNumber, BtreeMagicNumber:
+	case HashMagicNumberBE, BtreeMagicNumberBE:
+		page.Swapped = true
+		err = binary.Read(bytes.NewReader(data), binary.BigEndian, &page.GenericMetadataPage)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to unpack MetadataPage: %w", err)
+		}
+	default:
+		return nil, xerrors.Errorf("unexpected DB magic number: %+v", page.Magic)
+	}
+
+	return &page, page.validate()
+}
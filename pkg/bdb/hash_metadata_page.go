@@ -16,6 +16,8 @@ type HashMetadata struct {
 	FillFactor  uint32 `struct:"uint32"` /* 84-87: Fill factor */
 	NumKeys     uint32 `struct:"uint32"` /* 88-91: Number of keys in hash table */
 	CharKeyHash uint32 `struct:"uint32"` /* 92-95: Value of hash(CHARKEY) */
+	/* 96-223: Spare pages for overflow, i.e. where each doubling of the buckets starts */
+	Spares [32]uint32 `struct:"[32]uint32"`
 	// don't care about the rest...
 }
 
@@ -35,9 +37,9 @@ func ParseHashMetadataPage(data []byte) (*HashMetadataPage, error) {
 	}
 
 	if metadata.Magic == HashMagicNumberBE {
-		// Re-read the generic metadata as BigEndian
+		// Re-read the metadata as BigEndian
 		pageMetadata.Swapped = true
-		err := binary.Read(bytes.NewReader(data), binary.BigEndian, &metadata.GenericMetadataPage)
+		err := binary.Read(bytes.NewReader(data), binary.BigEndian, &metadata)
 		if err != nil {
 			return nil, xerrors.Errorf("failed to unpack HashMetadataPage: %w", err)
 		}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/binary"
 	"io"
-	"os"
 
 	"golang.org/x/xerrors"
 )
@@ -31,7 +30,7 @@ func ParseHashPage(data []byte, swapped bool) (*HashPage, error) {
 	return &hashPage, nil
 }
 
-func HashPageValueContent(db *os.File, pageData []byte, hashPageIndex uint16, pageSize uint32, swapped bool) ([]byte, error) {
+func HashPageValueContent(db io.ReaderAt, pageData []byte, hashPageIndex uint16, pageSize uint32, swapped bool) ([]byte, error) {
 	// the first byte is the page type, so we can peek at it first before parsing further...
 	valuePageType := pageData[hashPageIndex]
 
@@ -47,17 +46,18 @@ func HashPageValueContent(db *os.File, pageData []byte, hashPageIndex uint16, pa
 		return nil, err
 	}
 
+	return overflowContent(db, entry.PageNo, pageSize, swapped)
+}
+
+// overflowContent concatenates the data of the chain of overflow pages starting
+// at pageNo.
+func overflowContent(db io.ReaderAt, pageNo uint32, pageSize uint32, swapped bool) ([]byte, error) {
 	var hashValue []byte
 
-	for currentPageNo := entry.PageNo; currentPageNo != 0; {
-		pageStart := pageSize * currentPageNo
+	for currentPageNo := pageNo; currentPageNo != 0; {
+		pageStart := int64(pageSize) * int64(currentPageNo)
 
-		_, err := db.Seek(int64(pageStart), io.SeekStart)
-		if err != nil {
-			return nil, xerrors.Errorf("failed to seek to HashPageValueContent (page=%d): %w", currentPageNo, err)
-		}
-
-		currentPageBuff, err := slice(db, int(pageSize))
+		currentPageBuff, err := slice(db, pageStart, int(pageSize))
 		if err != nil {
 			return nil, xerrors.Errorf("failed to read page=%d: %w", currentPageNo, err)
 		}
@@ -86,34 +86,10 @@ func HashPageValueContent(db *os.File, pageData []byte, hashPageIndex uint16, pa
 	return hashValue, nil
 }
 
-func HashPageValueIndexes(data []byte, entries uint16, swapped bool) ([]uint16, error) {
-	order := byteOrder(swapped)
-	hashIndexValues := make([]uint16, 0)
-	if entries%2 != 0 {
-		return nil, xerrors.Errorf("invalid hash index: entries should only come in pairs (%+v)", entries)
-	}
-
-	// Every entry is a 2-byte offset that points somewhere in the current database page.
-	hashIndexSize := entries * HashIndexEntrySize
-	hashIndexData := data[PageHeaderSize : PageHeaderSize+hashIndexSize]
-
-	// data is stored in key-value pairs (https://github.com/berkeleydb/libdb/blob/5b7b02ae052442626af54c176335b67ecc613a30/src/dbinc/db_page.h#L591)
-	// skip over keys and only keep values
-	const keyValuePairSize = 2 * HashIndexEntrySize
-	for idx := range hashIndexData {
-		if (idx-HashIndexEntrySize)%keyValuePairSize == 0 {
-			value := order.Uint16(hashIndexData[idx : idx+2])
-			hashIndexValues = append(hashIndexValues, value)
-		}
-	}
-
-	return hashIndexValues, nil
-}
-
-func slice(reader io.Reader, n int) ([]byte, error) {
+func slice(reader io.ReaderAt, offset int64, n int) ([]byte, error) {
 	newBuff := make([]byte, n)
-	numRead, err := reader.Read(newBuff)
-	if err != nil {
+	numRead, err := reader.ReadAt(newBuff, offset)
+	if err != nil && (err != io.EOF || numRead == 0) {
 		return nil, xerrors.Errorf("failed to read page: %w", err)
 	}
 	if numRead != n {
@@ -0,0 +1,298 @@
+package bdb
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"golang.org/x/xerrors"
+)
+
+// https://github.com/berkeleydb/libdb/blob/v5.3.28/src/dbinc/db_page.h
+const (
+	BtreeLeafPageType PageType = 5
+
+	// item types of btree pages, the high bit marks a deleted item
+	btreeKeyDataType   = 1
+	btreeDuplicateType = 2
+	btreeOverflowType  = 3
+	btreeDeleted       = 0x80
+
+	// item types of hash pages, ref. HashOffIndexPageType
+	hashKeyDataType = 1
+
+	indexItemSize = 8 // sizeof(dbiIndexItem)
+)
+
+// Index is one of rpm's secondary indexes next to the Packages file, e.g. Name or
+// Basenames. It maps keys to the header numbers of the packages, and is a btree
+// database, or a hash database for rpm versions older than 4.9.
+type Index struct {
+	r        io.ReaderAt
+	closer   io.Closer
+	Metadata *MetadataPage
+}
+
+// IndexItem is an entry of an index: the header number of a package and the
+// position of the key within the tag, e.g. the file number for Basenames.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/dbiset.h
+type IndexItem struct {
+	HeaderNum uint32
+	TagNum    uint32
+}
+
+func OpenIndex(path string) (*Index, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := OpenIndexReaderAt(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	idx.closer = file
+
+	return idx, nil
+}
+
+// OpenIndexReaderAt reads an index from r. Closing the returned index does not
+// close r.
+func OpenIndexReaderAt(r io.ReaderAt) (*Index, error) {
+	metadataBuff := make([]byte, 512)
+	n, err := r.ReadAt(metadataBuff, 0)
+	if err != nil && (err != io.EOF || n == 0) {
+		return nil, xerrors.Errorf("failed to read metadata: %w", err)
+	}
+
+	metadata, err := ParseMetadataPage(metadataBuff[:n])
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := validPageSizes[metadata.PageSize]; !ok {
+		return nil, xerrors.Errorf("unexpected page size: %+v", metadata.PageSize)
+	}
+
+	return &Index{
+		r:        r,
+		Metadata: metadata,
+	}, nil
+}
+
+func (idx *Index) Close() error {
+	if idx.closer == nil {
+		return nil
+	}
+	return idx.closer.Close()
+}
+
+// LookupContext returns the items stored under key. Instead of descending the
+// tree, every leaf page is scanned, which keeps working on a database whose
+// internal pages are inconsistent and is still much cheaper than decoding all
+// the package headers.
+func (idx *Index) LookupContext(ctx context.Context, key []byte) ([]IndexItem, error) {
+	pageSize := idx.Metadata.PageSize
+
+	var items []IndexItem
+	for pageNum := uint32(1); pageNum <= idx.Metadata.LastPageNo; pageNum++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		pageData, err := slice(idx.r, int64(pageNum)*int64(pageSize), int(pageSize))
+		if err != nil {
+			return nil, err
+		}
+		page, err := ParseHashPage(pageData, idx.Metadata.Swapped)
+		if err != nil {
+			return nil, err
+		}
+
+		var data [][]byte
+		switch page.PageType {
+		case BtreeLeafPageType:
+			data, err = idx.btreeLookup(pageData, page, key)
+		case HashPageType, HashUnsortedPageType:
+			data, err = idx.hashLookup(pageData, page, key)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read page=%d: %w", pageNum, err)
+		}
+
+		for _, d := range data {
+			dataItems, err := idx.parseItems(d)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, dataItems...)
+		}
+	}
+	return items, nil
+}
+
+// btreeLookup returns the data of the key/data pairs of a leaf page matching key.
+func (idx *Index) btreeLookup(pageData []byte, page *HashPage, key []byte) ([][]byte, error) {
+	offsets, err := pageOffsets(pageData, page.NumEntries, idx.Metadata.Swapped)
+	if err != nil {
+		return nil, err
+	}
+
+	var data [][]byte
+	for i := 0; i+1 < len(offsets); i += 2 {
+		k, err := idx.btreeItem(pageData, offsets[i])
+		if err != nil {
+			return nil, err
+		}
+		if k == nil || !bytes.Equal(k, key) {
+			continue
+		}
+		d, err := idx.btreeItem(pageData, offsets[i+1])
+		if err != nil {
+			return nil, err
+		}
+		if d != nil {
+			data = append(data, d)
+		}
+	}
+	return data, nil
+}
+
+// btreeItem returns the content of a BKEYDATA or BOVERFLOW item, or nil for a
+// deleted item.
+// source: https://github.com/berkeleydb/libdb/blob/v5.3.28/src/dbinc/db_page.h#L643-L676
+func (idx *Index) btreeItem(pageData []byte, offset uint16) ([]byte, error) {
+	order := byteOrder(idx.Metadata.Swapped)
+	if int(offset)+3 > len(pageData) {
+		return nil, xerrors.Errorf("invalid item offset: %d", offset)
+	}
+
+	itemType := pageData[offset+2]
+	if itemType&btreeDeleted != 0 {
+		return nil, nil
+	}
+
+	switch itemType {
+	case btreeKeyDataType:
+		length := int(order.Uint16(pageData[offset:]))
+		start := int(offset) + 3
+		if start+length > len(pageData) {
+			return nil, xerrors.Errorf("invalid item length: %d", length)
+		}
+		return pageData[start : start+length], nil
+	case btreeOverflowType:
+		if int(offset)+12 > len(pageData) {
+			return nil, xerrors.Errorf("invalid item offset: %d", offset)
+		}
+		pageNo := order.Uint32(pageData[offset+4:])
+		length := order.Uint32(pageData[offset+8:])
+		content, err := overflowContent(idx.r, pageNo, idx.Metadata.PageSize, idx.Metadata.Swapped)
+		if err != nil {
+			return nil, err
+		}
+		if uint32(len(content)) < length {
+			return nil, xerrors.Errorf("short overflow item: %d<%d", len(content), length)
+		}
+		return content[:length], nil
+	case btreeDuplicateType:
+		return nil, xerrors.New("off-page duplicates are not supported")
+	default:
+		return nil, xerrors.Errorf("unexpected item type: %d", itemType)
+	}
+}
+
+// hashLookup returns the data of the key/data pairs of a hash page matching key.
+func (idx *Index) hashLookup(pageData []byte, page *HashPage, key []byte) ([][]byte, error) {
+	offsets, err := pageOffsets(pageData, page.NumEntries, idx.Metadata.Swapped)
+	if err != nil {
+		return nil, err
+	}
+
+	var data [][]byte
+	for i := 0; i+1 < len(offsets); i += 2 {
+		k, err := idx.hashItem(pageData, offsets, i)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(k, key) {
+			continue
+		}
+		d, err := idx.hashItem(pageData, offsets, i+1)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, d)
+	}
+	return data, nil
+}
+
+// hashItem returns the content of the i-th H_KEYDATA or H_OFFPAGE item of a hash
+// page. Items are stored from the end of the page, so an item ends where the
+// previous one starts.
+// source: https://github.com/berkeleydb/libdb/blob/v5.3.28/src/dbinc/hash.h
+func (idx *Index) hashItem(pageData []byte, offsets []uint16, i int) ([]byte, error) {
+	end := len(pageData)
+	if i > 0 {
+		end = int(offsets[i-1])
+	}
+	start := int(offsets[i])
+	if start >= end || end > len(pageData) {
+		return nil, xerrors.Errorf("invalid item offset: %d", start)
+	}
+
+	switch pageData[start] {
+	case hashKeyDataType:
+		return pageData[start+1 : end], nil
+	case HashOffIndexPageType:
+		entry, err := ParseHashOffPageEntry(pageData[start:end], idx.Metadata.Swapped)
+		if err != nil {
+			return nil, err
+		}
+		content, err := overflowContent(idx.r, entry.PageNo, idx.Metadata.PageSize, idx.Metadata.Swapped)
+		if err != nil {
+			return nil, err
+		}
+		if uint32(len(content)) < entry.Length {
+			return nil, xerrors.Errorf("short overflow item: %d<%d", len(content), entry.Length)
+		}
+		return content[:entry.Length], nil
+	default:
+		return nil, xerrors.Errorf("unexpected item type: %d", pageData[start])
+	}
+}
+
+// parseItems decodes the array of dbiIndexItem an index stores for a key.
+func (idx *Index) parseItems(data []byte) ([]IndexItem, error) {
+	if len(data)%indexItemSize != 0 {
+		return nil, xerrors.Errorf("invalid index data length: %d", len(data))
+	}
+
+	order := byteOrder(idx.Metadata.Swapped)
+	var items []IndexItem
+	for i := 0; i < len(data); i += indexItemSize {
+		items = append(items, IndexItem{
+			HeaderNum: order.Uint32(data[i:]),
+			TagNum:    order.Uint32(data[i+4:]),
+		})
+	}
+	return items, nil
+}
+
+// pageOffsets returns the in-page offsets of the items of a btree or hash page.
+func pageOffsets(data []byte, entries uint16, swapped bool) ([]uint16, error) {
+	order := byteOrder(swapped)
+	end := PageHeaderSize + int(entries)*HashIndexEntrySize
+	if end > len(data) {
+		return nil, xerrors.Errorf("invalid number of entries: %d", entries)
+	}
+
+	offsets := make([]uint16, 0, entries)
+	for i := PageHeaderSize; i < end; i += HashIndexEntrySize {
+		offsets = append(offsets, order.Uint16(data[i:]))
+	}
+	return offsets, nil
+}
@@ -0,0 +1,70 @@
+package rpmdb
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// ChangelogEntry is an entry of the %changelog of a package.
+type ChangelogEntry struct {
+	Time time.Time
+	Name string // the author, often followed by the version, e.g. "John Doe <jdoe@example.com> - 1.0-1"
+	Text string
+}
+
+// String formats the entry the way rpm -q --changelog does.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/rpmpopt.in#L56-L58
+func (e ChangelogEntry) String() string {
+	return fmt.Sprintf("* %s %s\n%s\n", e.Time.Format("Mon Jan 02 2006"), e.Name, e.Text)
+}
+
+// Changelog returns the changelog of the package, newest entry first. Builds may
+// trim old entries with %_changelog_trimtime, so it can be shorter than in the
+// spec file.
+func (p *PackageInfo) Changelog() ([]ChangelogEntry, error) {
+	if len(p.ChangelogNames) != len(p.ChangelogTimes) || len(p.ChangelogTexts) != len(p.ChangelogTimes) {
+		return nil, xerrors.Errorf("invalid rpm %s: %d changelog times, %d names and %d texts", p.Name,
+			len(p.ChangelogTimes), len(p.ChangelogNames), len(p.ChangelogTexts))
+	}
+
+	var entries []ChangelogEntry
+	for i, t := range p.ChangelogTimes {
+		entries = append(entries, ChangelogEntry{
+			Time: time.Unix(int64(uint32(t)), 0).UTC(),
+			Name: p.ChangelogNames[i],
+			Text: p.ChangelogTexts[i],
+		})
+	}
+	return entries, nil
+}
+
+// ref. https://cve.mitre.org/cve/identifiers/syntaxchange.html
+var cvePattern = regexp.MustCompile(`(?i)\bCVE-\d{4}-\d{4,}\b`)
+
+// ChangelogCVEs returns the CVE IDs mentioned in the changelog, which usually
+// are vulnerabilities fixed by the package, e.g. with a backported patch. They
+// are upper case and listed once, newest entry first.
+func (p *PackageInfo) ChangelogCVEs() ([]string, error) {
+	entries, err := p.Changelog()
+	if err != nil {
+		return nil, err
+	}
+
+	var cves []string
+	seen := make(map[string]struct{})
+	for _, entry := range entries {
+		for _, cve := range cvePattern.FindAllString(entry.Text, -1) {
+			cve = strings.ToUpper(cve)
+			if _, ok := seen[cve]; ok {
+				continue
+			}
+			seen[cve] = struct{}{}
+			cves = append(cves, cve)
+		}
+	}
+	return cves, nil
+}
@@ -0,0 +1,114 @@
+package rpmdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageInfo_Changelog(t *testing.T) {
+	tests := []struct {
+		name        string
+		file        string // Test input file
+		pkgName     string
+		wantEntries int
+		wantFirst   ChangelogEntry
+	}{
+		{
+			name:        "BerkeleyDB",
+			file:        "testdata/libuuid/Packages",
+			pkgName:     "libuuid",
+			wantEntries: 570,
+			wantFirst: ChangelogEntry{
+				Time: time.Date(2023, 3, 30, 12, 0, 0, 0, time.UTC),
+				Name: "Karel Zak <kzak@redhat.com> 2.32.1-42",
+				Text: "- fix #2180413 - Backport hint about systemd daemon-reload",
+			},
+		},
+		{
+			name:        "NDB",
+			file:        "testdata/sle15-bci/Packages.db",
+			pkgName:     "glibc",
+			wantEntries: 129,
+			wantFirst: ChangelogEntry{
+				Time: time.Date(2021, 9, 2, 12, 0, 0, 0, time.UTC),
+				Name: "schwab@suse.de",
+				Text: "- mq-notify-use-after-free.patch: Use __pthread_attr_copy in mq_notify\n  (CVE-2021-33574, bsc#1186489, BZ #27896)",
+			},
+		},
+		{
+			name:        "SQLite3",
+			file:        "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			pkgName:     "glibc",
+			wantEntries: 57,
+			wantFirst: ChangelogEntry{
+				Time: time.Date(2021, 11, 4, 12, 0, 0, 0, time.UTC),
+				Name: "Pawel Winogrodzki <pawel.winogrodzki@microsoft.com> - 2.34-2",
+				Text: "- Adding missing BR on \"perl(File::Find)\".\n- Fixing licensing information.\n- Removing redundant 'Provides'.",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkg, err := db.Package(tt.pkgName)
+			require.NoError(t, err)
+			got, err := pkg.Changelog()
+			require.NoError(t, err)
+			require.Len(t, got, tt.wantEntries)
+			assert.Equal(t, tt.wantFirst, got[0])
+			for i := 1; i < len(got); i++ {
+				assert.False(t, got[i].Time.After(got[i-1].Time), "entries are sorted newest first")
+			}
+		})
+	}
+}
+
+func TestPackageInfo_Changelog_invalid(t *testing.T) {
+	pkg := &PackageInfo{
+		Name:           "foo",
+		ChangelogTimes: []int32{1, 2},
+		ChangelogNames: []string{"a"},
+		ChangelogTexts: []string{"b", "c"},
+	}
+	_, err := pkg.Changelog()
+	assert.ErrorContains(t, err, "invalid rpm foo")
+}
+
+func TestChangelogEntry_String(t *testing.T) {
+	entry := ChangelogEntry{
+		Time: time.Date(2023, 3, 7, 12, 0, 0, 0, time.UTC),
+		Name: "John Doe <jdoe@example.com> - 1.0-2",
+		Text: "- Resolves: CVE-2023-0001",
+	}
+	assert.Equal(t, "* Tue Mar 07 2023 John Doe <jdoe@example.com> - 1.0-2\n- Resolves: CVE-2023-0001\n", entry.String())
+}
+
+func TestPackageInfo_ChangelogCVEs(t *testing.T) {
+	pkg := &PackageInfo{
+		ChangelogTimes: []int32{3, 2, 1},
+		ChangelogNames: []string{"a", "b", "c"},
+		ChangelogTexts: []string{
+			"- Resolves: CVE-2023-12345\n- Fix cve-2022-0001 (bsc#1)",
+			"- Backport fix for CVE-2023-12345 and CVE-2021-3999",
+			"- Not a CVE: XCVE-2020-1234, CVE-20-1",
+		},
+	}
+	got, err := pkg.ChangelogCVEs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CVE-2023-12345", "CVE-2022-0001", "CVE-2021-3999"}, got)
+
+	db, err := Open("testdata/sle15-bci/Packages.db")
+	require.NoError(t, err)
+	defer db.Close()
+	glibc, err := db.Package("glibc")
+	require.NoError(t, err)
+	got, err = glibc.ChangelogCVEs()
+	require.NoError(t, err)
+	assert.Equal(t, "CVE-2021-33574", got[0])
+}
@@ -1,11 +1,65 @@
 package dbi
 
+import (
+	"context"
+
+	"golang.org/x/xerrors"
+)
+
 type Entry struct {
-	Value []byte
-	Err   error
+	// HeaderNum identifies the header within the database: the hnum of SQLite,
+	// the PkgIndex of NDB or the hash key of Berkeley DB.
+	HeaderNum uint32
+	Value     []byte
+	Err       error
 }
 
 type RpmDBInterface interface {
 	Read() <-chan Entry
 	Close() error
 }
+
+// ContextReader is implemented by backends that stop reading, and close the
+// channel, once ctx is done. The entries of a backend implementing only Read
+// are read to the end even if the caller stops early.
+type ContextReader interface {
+	ReadContext(ctx context.Context) <-chan Entry
+}
+
+// Send delivers entry unless ctx is done first, so that a backend goroutine
+// never blocks on a consumer that has stopped reading. It reports whether the
+// entry was delivered.
+func Send(ctx context.Context, entries chan<- Entry, entry Entry) bool {
+	select {
+	case entries <- entry:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Index is a secondary index rpm maintains next to the package headers.
+type Index string
+
+const (
+	IndexName        Index = "Name"
+	IndexBasenames   Index = "Basenames"
+	IndexProvidename Index = "Providename"
+)
+
+var (
+	ErrorIndexNotFound  = xerrors.New("index not found")
+	ErrorHeaderNotFound = xerrors.New("header not found")
+)
+
+// Indexer is implemented by backends that can read rpm's secondary indexes and
+// fetch a single header by its number instead of reading the whole database.
+type Indexer interface {
+	// LookupContext returns the numbers of the headers having key in index. It
+	// returns ErrorIndexNotFound if the backend cannot read the index.
+	LookupContext(ctx context.Context, index Index, key string) ([]uint32, error)
+
+	// GetContext returns the header blob with the given number, or
+	// ErrorHeaderNotFound.
+	GetContext(ctx context.Context, hnum uint32) ([]byte, error)
+}
@@ -0,0 +1,138 @@
+package rpmdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DependencyProblemKind tells an unsatisfied requirement from a conflict.
+type DependencyProblemKind int
+
+const (
+	DependencyUnsatisfied DependencyProblemKind = iota
+	DependencyConflict
+)
+
+// DependencyProblem is a broken dependency of an installed package.
+type DependencyProblem struct {
+	Kind DependencyProblemKind
+
+	// Package is the package that has the requirement or conflict.
+	Package    *PackageInfo
+	Dependency Dependency
+
+	// ConflictsWith lists the installed packages matching a conflict.
+	ConflictsWith []*PackageInfo
+}
+
+// String formats the problem like rpm does, e.g. "libfoo.so.1()(64bit) is needed
+// by bar-1.0-1.x86_64".
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/rpmprob.c
+func (p DependencyProblem) String() string {
+	switch p.Kind {
+	case DependencyConflict:
+		return fmt.Sprintf("%s conflicts with %s", p.Dependency, p.Package.NEVRA())
+	default:
+		return fmt.Sprintf("%s is needed by %s", p.Dependency, p.Package.NEVRA())
+	}
+}
+
+// CheckDependencies checks the requires and conflicts of every installed package
+// against the provides and files of the others, like rpm -Va --nofiles. rpmlib()
+// requirements, which rpm itself satisfies, and Requires(hint) are skipped.
+func (d *RpmDB) CheckDependencies() ([]DependencyProblem, error) {
+	return d.CheckDependenciesContext(context.Background())
+}
+
+// CheckDependenciesContext is like CheckDependencies, but gives up once ctx is done.
+func (d *RpmDB) CheckDependenciesContext(ctx context.Context) ([]DependencyProblem, error) {
+	idx, err := d.packageIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return checkDependencies(idx)
+}
+
+func checkDependencies(idx *packageIndex) ([]DependencyProblem, error) {
+	var problems []DependencyProblem
+	// a requirement repeated for several scriptlets, e.g. Requires(post) and
+	// Requires(postun), is reported once, like rpm's problem set does
+	// ref. appendProblem() in https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/rpmte.c
+	type problemKey struct {
+		kind    DependencyProblemKind
+		pkg     *PackageInfo
+		problem string
+	}
+	seen := make(map[problemKey]struct{})
+	addProblem := func(problem DependencyProblem) {
+		key := problemKey{kind: problem.Kind, pkg: problem.Package, problem: problem.String()}
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		problems = append(problems, problem)
+	}
+
+	for _, pkg := range idx.packages {
+		requires, err := pkg.RequireDependencies()
+		if err != nil {
+			return nil, err
+		}
+		for _, req := range requires {
+			if ignoredRequirement(req) {
+				continue
+			}
+			if !req.satisfied(func(dep Dependency) bool { return len(idx.whatProvides(dep)) > 0 }) {
+				addProblem(DependencyProblem{
+					Kind:       DependencyUnsatisfied,
+					Package:    pkg,
+					Dependency: req,
+				})
+			}
+		}
+
+		conflicts, err := pkg.ConflictDependencies()
+		if err != nil {
+			return nil, err
+		}
+		for _, conflict := range conflicts {
+			// a package never conflicts with itself
+			var matches []*PackageInfo
+			ok := conflict.satisfied(func(dep Dependency) bool {
+				m := idx.whatProvides(dep, pkg)
+				matches = append(matches, m...)
+				return len(m) > 0
+			})
+			if ok {
+				addProblem(DependencyProblem{
+					Kind:          DependencyConflict,
+					Package:       pkg,
+					Dependency:    conflict,
+					ConflictsWith: uniquePackages(matches),
+				})
+			}
+		}
+	}
+	return problems, nil
+}
+
+// ignoredRequirement reports whether req is a rpmlib() requirement, which rpm
+// itself satisfies, or a Requires(hint).
+func ignoredRequirement(req Dependency) bool {
+	return req.Flags&(RPMSENSE_RPMLIB|RPMSENSE_MISSINGOK) != 0 || strings.HasPrefix(req.Name, "rpmlib(")
+}
+
+// uniquePackages removes duplicates from pkgs, keeping the first occurrence.
+func uniquePackages(pkgs []*PackageInfo) []*PackageInfo {
+	seen := make(map[*PackageInfo]struct{}, len(pkgs))
+	var unique []*PackageInfo
+	for _, pkg := range pkgs {
+		if _, ok := seen[pkg]; ok {
+			continue
+		}
+		seen[pkg] = struct{}{}
+		unique = append(unique, pkg)
+	}
+	return unique
+}
@@ -0,0 +1,115 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRpmDB_CheckDependencies(t *testing.T) {
+	tests := []struct {
+		name string
+		file string // Test input file
+		want []string
+	}{
+		{
+			name: "BerkeleyDB with a single package",
+			file: "testdata/libuuid/Packages",
+			want: []string{
+				"/sbin/ldconfig is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"ld-linux-x86-64.so.2()(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"ld-linux-x86-64.so.2(GLIBC_2.3)(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"libc.so.6()(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"libc.so.6(GLIBC_2.14)(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"libc.so.6(GLIBC_2.2.5)(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"libc.so.6(GLIBC_2.25)(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"libc.so.6(GLIBC_2.28)(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"libc.so.6(GLIBC_2.3)(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"libc.so.6(GLIBC_2.3.4)(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"libc.so.6(GLIBC_2.4)(64bit) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+				"rtld(GNU_HASH) is needed by libuuid-2.32.1-42.el8_8.x86_64",
+			},
+		},
+		{
+			name: "SLE15 with packages removed by hand",
+			file: "testdata/sle15-bci/Packages.db",
+			want: []string{
+				"diffutils is needed by rpm-ndb-4.14.3-40.1.x86_64",
+				"fillup is needed by rpm-ndb-4.14.3-40.1.x86_64",
+				"grep is needed by rpm-ndb-4.14.3-40.1.x86_64",
+			},
+		},
+		{
+			name: "CBL-Mariner 2.0",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			problems, err := db.CheckDependencies()
+			require.NoError(t, err)
+
+			var got []string
+			for _, p := range problems {
+				assert.Equal(t, DependencyUnsatisfied, p.Kind)
+				got = append(got, p.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_checkDependencies(t *testing.T) {
+	pkg := func(name, version string, requires, conflicts, files []string) *PackageInfo {
+		p := &PackageInfo{
+			Name:            name,
+			Version:         version,
+			Release:         "1",
+			Arch:            "noarch",
+			Provides:        []string{name},
+			ProvideFlags:    []int32{int32(RPMSENSE_EQUAL)},
+			ProvideVersions: []string{version + "-1"},
+			Requires:        requires,
+			Conflicts:       conflicts,
+		}
+		for _, f := range files {
+			p.DirNames = append(p.DirNames, "/usr/bin")
+			p.DirIndexes = append(p.DirIndexes, int32(len(p.DirNames)-1))
+			p.BaseNames = append(p.BaseNames, f)
+		}
+		return p
+	}
+	bash := pkg("bash", "5.2", nil, nil, []string{"bash"})
+	foo := pkg("foo", "1.0", []string{"/usr/bin/bash", "bar", "rpmlib(PayloadIsZstd)", "(bash if foo)"}, []string{"bash", "foo"}, nil)
+	foo.RequireFlags = []int32{0, 0, int32(RPMSENSE_RPMLIB | RPMSENSE_LESS | RPMSENSE_EQUAL), 0}
+	foo.RequireVersions = []string{"", "", "5.4.18-1", ""}
+	foo.ConflictFlags = []int32{int32(RPMSENSE_LESS), 0}
+	foo.ConflictVersions = []string{"6.0", ""}
+	baz := pkg("baz", "1.0", []string{"bar"}, nil, nil)
+	baz.RequireFlags = []int32{int32(RPMSENSE_MISSINGOK)}
+	baz.RequireVersions = []string{""}
+
+	idx, err := newPackageIndex([]*PackageInfo{bash, foo, baz})
+	require.NoError(t, err)
+	problems, err := checkDependencies(idx)
+	require.NoError(t, err)
+	assert.Equal(t, []DependencyProblem{
+		{
+			Kind:       DependencyUnsatisfied,
+			Package:    foo,
+			Dependency: Dependency{Name: "bar"},
+		},
+		{
+			Kind:          DependencyConflict,
+			Package:       foo,
+			Dependency:    Dependency{Name: "bash", Flags: RPMSENSE_LESS, EVR: "6.0"},
+			ConflictsWith: []*PackageInfo{bash},
+		},
+	}, problems)
+	assert.Equal(t, "bash < 6.0 conflicts with foo-1.0-1.noarch", problems[1].String())
+}
@@ -0,0 +1,317 @@
+package rpmdb
+
+import (
+	"cmp"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// DependencyFlags are the rpmsenseFlags of a dependency.
+type DependencyFlags int32
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/include/rpm/rpmds.h
+const (
+	RPMSENSE_ANY           DependencyFlags = 0
+	RPMSENSE_LESS          DependencyFlags = 1 << 1
+	RPMSENSE_GREATER       DependencyFlags = 1 << 2
+	RPMSENSE_EQUAL         DependencyFlags = 1 << 3
+	RPMSENSE_POSTTRANS     DependencyFlags = 1 << 5  /*!< %posttrans dependency */
+	RPMSENSE_PREREQ        DependencyFlags = 1 << 6  /* legacy prereq dependency */
+	RPMSENSE_PRETRANS      DependencyFlags = 1 << 7  /*!< Pre-transaction dependency. */
+	RPMSENSE_INTERP        DependencyFlags = 1 << 8  /*!< Interpreter used by scriptlet. */
+	RPMSENSE_SCRIPT_PRE    DependencyFlags = 1 << 9  /*!< %pre dependency. */
+	RPMSENSE_SCRIPT_POST   DependencyFlags = 1 << 10 /*!< %post dependency. */
+	RPMSENSE_SCRIPT_PREUN  DependencyFlags = 1 << 11 /*!< %preun dependency. */
+	RPMSENSE_SCRIPT_POSTUN DependencyFlags = 1 << 12 /*!< %postun dependency. */
+	RPMSENSE_SCRIPT_VERIFY DependencyFlags = 1 << 13 /*!< %verify dependency. */
+	RPMSENSE_FIND_REQUIRES DependencyFlags = 1 << 14 /*!< find-requires generated dependency. */
+	RPMSENSE_FIND_PROVIDES DependencyFlags = 1 << 15 /*!< find-provides generated dependency. */
+	RPMSENSE_TRIGGERIN     DependencyFlags = 1 << 16 /*!< %triggerin dependency. */
+	RPMSENSE_TRIGGERUN     DependencyFlags = 1 << 17 /*!< %triggerun dependency. */
+	RPMSENSE_TRIGGERPOSTUN DependencyFlags = 1 << 18 /*!< %triggerpostun dependency. */
+	RPMSENSE_MISSINGOK     DependencyFlags = 1 << 19 /*!< suggests/enhances hint. */
+	RPMSENSE_PREUNTRANS    DependencyFlags = 1 << 20 /*!< %preuntrans dependency */
+	RPMSENSE_POSTUNTRANS   DependencyFlags = 1 << 21 /*!< %postuntrans dependency */
+	RPMSENSE_RPMLIB        DependencyFlags = 1 << 24 /*!< rpmlib(feature) dependency. */
+	RPMSENSE_TRIGGERPREIN  DependencyFlags = 1 << 25 /*!< %triggerprein dependency. */
+	RPMSENSE_KEYRING       DependencyFlags = 1 << 26
+	RPMSENSE_STRONG        DependencyFlags = 1 << 27 /* only used by old-style weak dependencies */
+	RPMSENSE_CONFIG        DependencyFlags = 1 << 28
+	RPMSENSE_META          DependencyFlags = 1 << 29 /*!< meta dependency. */
+
+	RPMSENSE_SENSEMASK = RPMSENSE_LESS | RPMSENSE_GREATER | RPMSENSE_EQUAL
+)
+
+// Sense returns the comparison part of the flags, e.g. RPMSENSE_GREATER|RPMSENSE_EQUAL.
+func (flags DependencyFlags) Sense() DependencyFlags {
+	return flags & RPMSENSE_SENSEMASK
+}
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/rpmds.c
+func (flags DependencyFlags) String() string {
+	var op string
+	if flags&RPMSENSE_LESS != 0 {
+		op += "<"
+	}
+	if flags&RPMSENSE_GREATER != 0 {
+		op += ">"
+	}
+	if flags&RPMSENSE_EQUAL != 0 {
+		op += "="
+	}
+	return op
+}
+
+// Dependency is a single entry of a dependency set such as requires or provides.
+type Dependency struct {
+	Name  string
+	Flags DependencyFlags
+	EVR   string // e.g. "1:3.0.7-1.el9", empty for an unversioned dependency
+}
+
+// String formats the dependency the way rpm -qR does, e.g. "openssl-libs >= 1:3.0.7".
+func (d Dependency) String() string {
+	if d.EVR == "" || d.Flags.Sense() == 0 {
+		return d.Name
+	}
+	return strings.Join([]string{d.Name, d.Flags.String(), d.EVR}, " ")
+}
+
+// RequireDependencies returns the requires of the package with their flags and versions.
+func (p *PackageInfo) RequireDependencies() ([]Dependency, error) {
+	return p.dependencies(p.Requires, p.RequireFlags, p.RequireVersions)
+}
+
+// ProvideDependencies returns the provides of the package with their flags and versions.
+func (p *PackageInfo) ProvideDependencies() ([]Dependency, error) {
+	return p.dependencies(p.Provides, p.ProvideFlags, p.ProvideVersions)
+}
+
+// dependencies zips the name, flags and version tags of a dependency set. Flags and
+// versions may be absent in headers built by very old rpm versions.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/rpmds.c
+func (p *PackageInfo) dependencies(names []string, flags []int32, versions []string) ([]Dependency, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	if flags != nil && len(flags) != len(names) || versions != nil && len(versions) != len(names) {
+		return nil, xerrors.Errorf("invalid rpm %s", p.Name)
+	}
+
+	deps := make([]Dependency, len(names))
+	for i, name := range names {
+		deps[i].Name = name
+		if flags != nil {
+			deps[i].Flags = DependencyFlags(flags[i])
+		}
+		if versions != nil {
+			deps[i].EVR = versions[i]
+		}
+	}
+	return deps, nil
+}
+
+// dependencySet points to the name, flags and version fields a dependency set is
+// decoded into.
+type dependencySet struct {
+	names    *[]string
+	flags    *[]int32
+	versions *[]string
+}
+
+// dependencyNameTags maps the flags and version tags of a dependency set to its
+// name tag.
+var dependencyNameTags = map[int32]int32{
+	RPMTAG_CONFLICTFLAGS:      RPMTAG_CONFLICTNAME,
+	RPMTAG_CONFLICTVERSION:    RPMTAG_CONFLICTNAME,
+	RPMTAG_OBSOLETEFLAGS:      RPMTAG_OBSOLETENAME,
+	RPMTAG_OBSOLETEVERSION:    RPMTAG_OBSOLETENAME,
+	RPMTAG_RECOMMENDFLAGS:     RPMTAG_RECOMMENDNAME,
+	RPMTAG_RECOMMENDVERSION:   RPMTAG_RECOMMENDNAME,
+	RPMTAG_SUGGESTFLAGS:       RPMTAG_SUGGESTNAME,
+	RPMTAG_SUGGESTVERSION:     RPMTAG_SUGGESTNAME,
+	RPMTAG_SUPPLEMENTFLAGS:    RPMTAG_SUPPLEMENTNAME,
+	RPMTAG_SUPPLEMENTVERSION:  RPMTAG_SUPPLEMENTNAME,
+	RPMTAG_ENHANCEFLAGS:       RPMTAG_ENHANCENAME,
+	RPMTAG_ENHANCEVERSION:     RPMTAG_ENHANCENAME,
+	RPMTAG_OLDSUGGESTSFLAGS:   RPMTAG_OLDSUGGESTSNAME,
+	RPMTAG_OLDSUGGESTSVERSION: RPMTAG_OLDSUGGESTSNAME,
+	RPMTAG_OLDENHANCESFLAGS:   RPMTAG_OLDENHANCESNAME,
+	RPMTAG_OLDENHANCESVERSION: RPMTAG_OLDENHANCESNAME,
+}
+
+// convertOldWeakDependencies fills in the weak dependencies of packages built
+// before rpm 4.12 got dedicated tags for them. Old-style suggests and enhances
+// marked with RPMSENSE_STRONG are recommends and supplements, and requires marked
+// with RPMSENSE_MISSINGOK (Requires(hint)) are suggests. Like in libsolv, headers
+// carrying the new tags are left alone.
+// ref. https://github.com/openSUSE/libsolv/blob/master/ext/repo_rpmdb.c
+func (p *PackageInfo) convertOldWeakDependencies(oldSuggests, oldEnhances dependencySet) {
+	if p.Recommends != nil || p.Suggests != nil || p.Supplements != nil || p.Enhances != nil {
+		return
+	}
+
+	strong := func(f DependencyFlags) bool { return f&RPMSENSE_STRONG != 0 }
+	weak := func(f DependencyFlags) bool { return f&RPMSENSE_STRONG == 0 }
+	missingOK := func(f DependencyFlags) bool { return f&RPMSENSE_MISSINGOK != 0 }
+
+	oldSuggests.appendTo(dependencySet{&p.Recommends, &p.RecommendFlags, &p.RecommendVersions}, strong)
+	oldSuggests.appendTo(dependencySet{&p.Suggests, &p.SuggestFlags, &p.SuggestVersions}, weak)
+	oldEnhances.appendTo(dependencySet{&p.Supplements, &p.SupplementFlags, &p.SupplementVersions}, strong)
+	oldEnhances.appendTo(dependencySet{&p.Enhances, &p.EnhanceFlags, &p.EnhanceVersions}, weak)
+
+	requires := dependencySet{&p.Requires, &p.RequireFlags, &p.RequireVersions}
+	requires.appendTo(dependencySet{&p.Suggests, &p.SuggestFlags, &p.SuggestVersions}, missingOK)
+}
+
+// appendTo appends the dependencies whose flags match to dst, without the
+// RPMSENSE_STRONG marker.
+func (s dependencySet) appendTo(dst dependencySet, match func(DependencyFlags) bool) {
+	for i, name := range *s.names {
+		var flags DependencyFlags
+		if i < len(*s.flags) {
+			flags = DependencyFlags((*s.flags)[i])
+		}
+		if !match(flags) {
+			continue
+		}
+		var version string
+		if i < len(*s.versions) {
+			version = (*s.versions)[i]
+		}
+		*dst.names = append(*dst.names, name)
+		*dst.flags = append(*dst.flags, int32(flags&^RPMSENSE_STRONG))
+		*dst.versions = append(*dst.versions, version)
+	}
+}
+
+// ConflictDependencies returns the conflicts of the package with their flags and versions.
+func (p *PackageInfo) ConflictDependencies() ([]Dependency, error) {
+	return p.dependencies(p.Conflicts, p.ConflictFlags, p.ConflictVersions)
+}
+
+// ObsoleteDependencies returns the obsoletes of the package with their flags and versions.
+func (p *PackageInfo) ObsoleteDependencies() ([]Dependency, error) {
+	return p.dependencies(p.Obsoletes, p.ObsoleteFlags, p.ObsoleteVersions)
+}
+
+// RecommendDependencies returns the recommends of the package with their flags and versions.
+func (p *PackageInfo) RecommendDependencies() ([]Dependency, error) {
+	return p.dependencies(p.Recommends, p.RecommendFlags, p.RecommendVersions)
+}
+
+// SuggestDependencies returns the suggests of the package with their flags and versions.
+func (p *PackageInfo) SuggestDependencies() ([]Dependency, error) {
+	return p.dependencies(p.Suggests, p.SuggestFlags, p.SuggestVersions)
+}
+
+// SupplementDependencies returns the supplements of the package with their flags and versions.
+func (p *PackageInfo) SupplementDependencies() ([]Dependency, error) {
+	return p.dependencies(p.Supplements, p.SupplementFlags, p.SupplementVersions)
+}
+
+// EnhanceDependencies returns the enhances of the package with their flags and versions.
+func (p *PackageInfo) EnhanceDependencies() ([]Dependency, error) {
+	return p.dependencies(p.Enhances, p.EnhanceFlags, p.EnhanceVersions)
+}
+
+// SatisfiedBy reports whether the dependency, typically a requirement, is satisfied
+// by provide. See SatisfiedByAny for rich dependencies.
+func (d Dependency) SatisfiedBy(provide Dependency) bool {
+	return d.SatisfiedByAny([]Dependency{provide})
+}
+
+// SatisfiedByAny reports whether the dependency is satisfied by the set of
+// provides, e.g. those of all installed packages. Rich dependencies like
+// "(foo if bar)" are evaluated against the whole set; a malformed one is never
+// satisfied.
+func (d Dependency) SatisfiedByAny(provides []Dependency) bool {
+	return d.satisfied(func(dep Dependency) bool {
+		for _, provide := range provides {
+			if dep.overlaps(provide) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// satisfied evaluates the dependency, using match to look up simple dependencies.
+func (d Dependency) satisfied(match func(Dependency) bool) bool {
+	if !d.IsRich() {
+		return match(d)
+	}
+	r, err := parseRichDependency(d.Name)
+	if err != nil {
+		return false
+	}
+	return r.satisfied(match)
+}
+
+// required returns the simple dependencies d needs the installed packages to
+// provide, which is d itself unless it is a rich dependency.
+func (d Dependency) required(match func(Dependency) bool) []Dependency {
+	if !d.IsRich() {
+		return []Dependency{d}
+	}
+	r, err := parseRichDependency(d.Name)
+	if err != nil {
+		return nil
+	}
+	return r.required(match)
+}
+
+// overlaps reports whether the ranges of two dependencies with the same name
+// overlap.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/rpmds.c
+func (d Dependency) overlaps(other Dependency) bool {
+	if d.Name != other.Name {
+		return false
+	}
+
+	// if either is an existence test or has no version, they always overlap
+	if d.Flags.Sense() == 0 || other.Flags.Sense() == 0 || d.EVR == "" || other.EVR == "" {
+		return true
+	}
+
+	return evrOverlap(ParseEVR(d.EVR), d.Flags, ParseEVR(other.EVR), other.Flags)
+}
+
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/rpmio/rpmver.c
+func evrOverlap(v1 EVR, f1 DependencyFlags, v2 EVR, f2 DependencyFlags) bool {
+	// a missing epoch only loses against a positive one
+	sense := 0
+	switch {
+	case v1.Epoch != nil && v2.Epoch != nil:
+		sense = cmp.Compare(*v1.Epoch, *v2.Epoch)
+	case v1.Epoch != nil && *v1.Epoch > 0:
+		sense = 1
+	case v2.Epoch != nil && *v2.Epoch > 0:
+		sense = -1
+	}
+
+	if sense == 0 {
+		sense = VersionCompare(v1.Version, v2.Version)
+		if sense == 0 {
+			if v1.Release != "" && v2.Release != "" {
+				sense = VersionCompare(v1.Release, v2.Release)
+			} else if v1.Release != "" && f2&RPMSENSE_EQUAL != 0 || v2.Release != "" && f1&RPMSENSE_EQUAL != 0 {
+				// always matches if the side with no release has RPMSENSE_EQUAL
+				return true
+			}
+		}
+	}
+
+	// detect overlap of the ranges
+	switch {
+	case sense < 0:
+		return f1&RPMSENSE_GREATER != 0 || f2&RPMSENSE_LESS != 0
+	case sense > 0:
+		return f1&RPMSENSE_LESS != 0 || f2&RPMSENSE_GREATER != 0
+	default:
+		return f1&RPMSENSE_EQUAL != 0 && f2&RPMSENSE_EQUAL != 0 ||
+			f1&RPMSENSE_LESS != 0 && f2&RPMSENSE_LESS != 0 ||
+			f1&RPMSENSE_GREATER != 0 && f2&RPMSENSE_GREATER != 0
+	}
+}
@@ -0,0 +1,268 @@
+package rpmdb
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependency_String(t *testing.T) {
+	tests := []struct {
+		dep  Dependency
+		want string
+	}{
+		{
+			dep:  Dependency{Name: "openssl-libs", Flags: RPMSENSE_GREATER | RPMSENSE_EQUAL, EVR: "1:3.0.7"},
+			want: "openssl-libs >= 1:3.0.7",
+		},
+		{
+			dep:  Dependency{Name: "rpmlib(PayloadIsXz)", Flags: RPMSENSE_RPMLIB | RPMSENSE_LESS | RPMSENSE_EQUAL, EVR: "5.2-1"},
+			want: "rpmlib(PayloadIsXz) <= 5.2-1",
+		},
+		{
+			dep:  Dependency{Name: "glibc", Flags: RPMSENSE_LESS, EVR: "2.28"},
+			want: "glibc < 2.28",
+		},
+		{
+			dep:  Dependency{Name: "/sbin/ldconfig", Flags: RPMSENSE_INTERP | RPMSENSE_SCRIPT_POST},
+			want: "/sbin/ldconfig",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.dep.String())
+		})
+	}
+}
+
+func TestPackageInfo_Dependencies(t *testing.T) {
+	db, err := Open("testdata/libuuid/Packages")
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkg, err := db.Package("libuuid")
+	require.NoError(t, err)
+
+	requires, err := pkg.RequireDependencies()
+	require.NoError(t, err)
+	require.Len(t, requires, 17)
+	assert.Equal(t, Dependency{Name: "/sbin/ldconfig", Flags: RPMSENSE_INTERP | RPMSENSE_SCRIPT_POST}, requires[0])
+	assert.Equal(t, Dependency{Name: "/sbin/ldconfig", Flags: RPMSENSE_INTERP | RPMSENSE_SCRIPT_POSTUN}, requires[1])
+	assert.Equal(t, Dependency{Name: "libc.so.6()(64bit)", Flags: RPMSENSE_FIND_REQUIRES}, requires[4])
+	assert.Equal(t, Dependency{
+		Name:  "rpmlib(PayloadIsXz)",
+		Flags: RPMSENSE_RPMLIB | RPMSENSE_LESS | RPMSENSE_EQUAL,
+		EVR:   "5.2-1",
+	}, requires[15])
+
+	provides, err := pkg.ProvideDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []Dependency{
+		{Name: "libuuid", Flags: RPMSENSE_EQUAL, EVR: "2.32.1-42.el8_8"},
+		{Name: "libuuid(x86-64)", Flags: RPMSENSE_EQUAL, EVR: "2.32.1-42.el8_8"},
+		{Name: "libuuid.so.1()(64bit)", Flags: RPMSENSE_FIND_PROVIDES},
+		{Name: "libuuid.so.1(UUIDD_PRIVATE)(64bit)", Flags: RPMSENSE_FIND_PROVIDES},
+		{Name: "libuuid.so.1(UUID_1.0)(64bit)", Flags: RPMSENSE_FIND_PROVIDES},
+		{Name: "libuuid.so.1(UUID_2.20)(64bit)", Flags: RPMSENSE_FIND_PROVIDES},
+		{Name: "libuuid.so.1(UUID_2.31)(64bit)", Flags: RPMSENSE_FIND_PROVIDES},
+	}, provides)
+}
+
+func TestPackageInfo_Dependencies_invalid(t *testing.T) {
+	pkg := &PackageInfo{
+		Name:         "broken",
+		Requires:     []string{"a", "b"},
+		RequireFlags: []int32{0},
+	}
+	_, err := pkg.RequireDependencies()
+	assert.Error(t, err)
+
+	// headers without flags and versions yield unversioned dependencies
+	pkg = &PackageInfo{Provides: []string{"a"}}
+	got, err := pkg.ProvideDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []Dependency{{Name: "a"}}, got)
+}
+
+func TestPackageInfo_WeakDependencies(t *testing.T) {
+	db, err := Open("testdata/sle15-bci/Packages.db")
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkg, err := db.Package("sles-release")
+	require.NoError(t, err)
+
+	conflicts, err := pkg.ConflictDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []Dependency{
+		{Name: "kernel", Flags: RPMSENSE_LESS, EVR: "4.4"},
+		{Name: "otherproviders(distribution-release)"},
+		{Name: "perl-Bootloader", Flags: RPMSENSE_LESS, EVR: "0.904"},
+	}, conflicts)
+
+	recommends, err := pkg.RecommendDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"(yast2-vm if patterns-yast-yast2_basis)",
+		"branding",
+		"issue-generator",
+		"release-notes-sles",
+	}, dependencyNames(recommends))
+
+	suggests, err := pkg.SuggestDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kernel-default"}, dependencyNames(suggests))
+
+	supplements, err := pkg.SupplementDependencies()
+	require.NoError(t, err)
+	assert.Empty(t, supplements)
+}
+
+func TestPackageInfo_oldWeakDependencies(t *testing.T) {
+	stringArray := func(tag int32, values ...string) indexEntry {
+		var data []byte
+		for _, v := range values {
+			data = append(data, v+"\x00"...)
+		}
+		return indexEntry{
+			Info:   entryInfo{Tag: tag, Type: RPM_STRING_ARRAY_TYPE, Count: uint32(len(values))},
+			Length: len(data),
+			Data:   data,
+		}
+	}
+	int32Array := func(tag int32, values ...DependencyFlags) indexEntry {
+		var data []byte
+		for _, v := range values {
+			data = binary.BigEndian.AppendUint32(data, uint32(v))
+		}
+		return indexEntry{
+			Info:   entryInfo{Tag: tag, Type: RPM_INT32_TYPE, Count: uint32(len(values))},
+			Length: len(data),
+			Data:   data,
+		}
+	}
+
+	pkg, err := getNEVRA([]indexEntry{
+		stringArray(RPMTAG_REQUIRENAME, "bash", "bash-doc"),
+		int32Array(RPMTAG_REQUIREFLAGS, RPMSENSE_ANY, RPMSENSE_MISSINGOK),
+		stringArray(RPMTAG_REQUIREVERSION, "", ""),
+		stringArray(RPMTAG_OLDSUGGESTSNAME, "a", "b"),
+		int32Array(RPMTAG_OLDSUGGESTSFLAGS, RPMSENSE_STRONG|RPMSENSE_GREATER|RPMSENSE_EQUAL, RPMSENSE_ANY),
+		stringArray(RPMTAG_OLDSUGGESTSVERSION, "1.0", ""),
+		stringArray(RPMTAG_OLDENHANCESNAME, "c", "d"),
+		int32Array(RPMTAG_OLDENHANCESFLAGS, RPMSENSE_STRONG, RPMSENSE_ANY),
+		stringArray(RPMTAG_OLDENHANCESVERSION, "", ""),
+	})
+	require.NoError(t, err)
+
+	recommends, err := pkg.RecommendDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []Dependency{{Name: "a", Flags: RPMSENSE_GREATER | RPMSENSE_EQUAL, EVR: "1.0"}}, recommends)
+
+	suggests, err := pkg.SuggestDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []Dependency{{Name: "b"}, {Name: "bash-doc", Flags: RPMSENSE_MISSINGOK}}, suggests)
+
+	supplements, err := pkg.SupplementDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []Dependency{{Name: "c"}}, supplements)
+
+	enhances, err := pkg.EnhanceDependencies()
+	require.NoError(t, err)
+	assert.Equal(t, []Dependency{{Name: "d"}}, enhances)
+}
+
+func dependencyNames(deps []Dependency) []string {
+	var names []string
+	for _, d := range deps {
+		names = append(names, d.Name)
+	}
+	return names
+}
+
+func TestDependency_SatisfiedBy(t *testing.T) {
+	dep := func(s string) Dependency {
+		r, err := parseRichDependency("(" + s + ")")
+		require.NoError(t, err)
+		return r.dep
+	}
+	tests := []struct {
+		name    string
+		require string
+		provide string
+		want    bool
+	}{
+		{name: "greater", require: "foo >= 1.0", provide: "foo = 1.2-1", want: true},
+		{name: "too old", require: "foo >= 2.0", provide: "foo = 1.2-1", want: false},
+		{name: "unversioned require", require: "foo", provide: "foo = 1", want: true},
+		{name: "unversioned provide", require: "foo >= 9", provide: "foo", want: true},
+		{name: "different name", require: "foo", provide: "bar", want: false},
+		{name: "release-less require", require: "foo = 1.0", provide: "foo = 1.0-3", want: true},
+		{name: "different release", require: "foo = 1.0-2", provide: "foo = 1.0-3", want: false},
+		{name: "release-less provide", require: "foo < 1.0-3", provide: "foo = 1.0", want: true},
+		{name: "strictly greater", require: "foo > 1.0", provide: "foo = 1.0-1", want: false},
+		{name: "less", require: "foo < 2.0", provide: "foo = 1.0-1", want: true},
+		{name: "less or equal", require: "foo <= 1.0-1", provide: "foo = 1.0-1", want: true},
+		{name: "epoch promotion", require: "foo >= 1.0", provide: "foo = 1:0.5-1", want: true},
+		{name: "required epoch", require: "foo >= 1:1.0", provide: "foo = 2.0-1", want: false},
+		{name: "zero epoch", require: "foo >= 0:1.0", provide: "foo = 1.0-1", want: true},
+		{name: "tilde", require: "foo >= 1.0", provide: "foo = 1.0~rc1-1", want: false},
+		{name: "caret", require: "foo > 1.0", provide: "foo = 1.0^git1-1", want: true},
+		{name: "provided range", require: "foo = 1.5", provide: "foo < 2.0", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dep(tt.require).SatisfiedBy(dep(tt.provide)))
+		})
+	}
+}
+
+func TestDependency_SatisfiedByAny(t *testing.T) {
+	provides := func(names ...string) []Dependency {
+		var deps []Dependency
+		for _, name := range names {
+			r, err := parseRichDependency("(" + name + ")")
+			require.NoError(t, err)
+			deps = append(deps, r.dep)
+		}
+		return deps
+	}
+	tests := []struct {
+		name     string
+		require  string
+		provides []Dependency
+		want     bool
+	}{
+		{name: "or", require: "(foo or bar)", provides: provides("bar"), want: true},
+		{name: "or unsatisfied", require: "(foo or bar)", provides: provides("baz"), want: false},
+		{name: "and", require: "(foo and bar)", provides: provides("foo", "bar"), want: true},
+		{name: "and unsatisfied", require: "(foo and bar)", provides: provides("foo"), want: false},
+		{name: "chained", require: "(a or b or c)", provides: provides("c"), want: true},
+		{name: "if", require: "(foo >= 2 if bar)", provides: provides("bar", "foo = 1"), want: false},
+		{name: "if without condition", require: "(foo >= 2 if bar)", provides: provides("foo = 1"), want: true},
+		{name: "if else", require: "(foo if bar else baz)", provides: provides("baz"), want: true},
+		{name: "if else unsatisfied", require: "(foo if bar else baz)", provides: nil, want: false},
+		{name: "unless", require: "(foo unless bar)", provides: provides("bar"), want: true},
+		{name: "unless unsatisfied", require: "(foo unless bar)", provides: nil, want: false},
+		{name: "unless else", require: "(foo unless bar else baz)", provides: provides("bar"), want: false},
+		{
+			name:     "with",
+			require:  "(libc.so.6()(64bit) with libc.so.6(GLIBC_2.34)(64bit))",
+			provides: provides("libc.so.6()(64bit)", "libc.so.6(GLIBC_2.34)(64bit)"),
+			want:     true,
+		},
+		{name: "without", require: "(foo without bar)", provides: provides("foo"), want: true},
+		{name: "without unsatisfied", require: "(foo without bar)", provides: provides("foo", "bar"), want: false},
+		{name: "nested", require: "((a or b) and c >= 1.0)", provides: provides("b", "c = 1.1"), want: true},
+		{name: "nested unsatisfied", require: "((a or b) and c >= 1.0)", provides: provides("b", "c = 0.9"), want: false},
+		{name: "malformed", require: "(foo or)", provides: provides("foo"), want: false},
+		{name: "mixed operators", require: "(a and b or c)", provides: provides("a", "b", "c"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Dependency{Name: tt.require}.SatisfiedByAny(tt.provides))
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package rpmdb
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// EVR is the epoch, version and release of a package or a versioned dependency.
+type EVR struct {
+	Epoch   *int // nil if the epoch is missing, which compares like 0
+	Version string
+	Release string // empty if the release is missing
+}
+
+// ParseEVR parses "[epoch:]version[-release]". Like rpm, it never fails: anything
+// that does not look like an epoch is part of the version. A "(none)" epoch, as
+// printed by rpm -q --qf, is treated as missing.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/rpmio/rpmver.c
+func ParseEVR(s string) EVR {
+	var evr EVR
+	s = strings.TrimPrefix(s, "(none):")
+
+	// the epoch is the leading digits up to ':'
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	if i < len(s) && s[i] == ':' {
+		epoch := 0
+		if i > 0 {
+			var err error
+			if epoch, err = strconv.Atoi(s[:i]); err != nil {
+				epoch = 0
+			}
+		}
+		evr.Epoch = &epoch
+		s = s[i+1:]
+	}
+
+	if j := strings.LastIndexByte(s, '-'); j >= 0 {
+		evr.Version, evr.Release = s[:j], s[j+1:]
+	} else {
+		evr.Version = s
+	}
+	return evr
+}
+
+// String formats the EVR as "[epoch:]version[-release]".
+func (e EVR) String() string {
+	var sb strings.Builder
+	if e.Epoch != nil {
+		sb.WriteString(strconv.Itoa(*e.Epoch))
+		sb.WriteByte(':')
+	}
+	sb.WriteString(e.Version)
+	if e.Release != "" {
+		sb.WriteByte('-')
+		sb.WriteString(e.Release)
+	}
+	return sb.String()
+}
+
+// Compare returns -1, 0 or +1 depending on whether e is older, the same or newer
+// than other. A missing epoch compares like 0 and the releases are only compared
+// if both are present, so that "1.0" matches any release of version 1.0.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/rpmio/rpmver.c
+func (e EVR) Compare(other EVR) int {
+	if rc := cmp.Compare(e.epoch(), other.epoch()); rc != 0 {
+		return rc
+	}
+	if rc := VersionCompare(e.Version, other.Version); rc != 0 {
+		return rc
+	}
+	if e.Release == "" || other.Release == "" {
+		return 0
+	}
+	return VersionCompare(e.Release, other.Release)
+}
+
+func (e EVR) epoch() int {
+	if e.Epoch == nil {
+		return 0
+	}
+	return *e.Epoch
+}
+
+// NEVRA identifies a package by name, epoch, version, release and architecture.
+type NEVRA struct {
+	Name string
+	EVR
+	Arch string
+}
+
+// ParseNEVRA parses "name-[epoch:]version-release.arch". The epoch may also be
+// given in front of the name, as yum prints it: "epoch:name-version-release.arch".
+func ParseNEVRA(s string) (NEVRA, error) {
+	var nevra NEVRA
+
+	dot := strings.LastIndexByte(s, '.')
+	if dot < 0 {
+		return NEVRA{}, xerrors.Errorf("invalid NEVRA %q: missing arch", s)
+	}
+	nevra.Arch = s[dot+1:]
+	nvr := s[:dot]
+
+	rel := strings.LastIndexByte(nvr, '-')
+	if rel < 0 {
+		return NEVRA{}, xerrors.Errorf("invalid NEVRA %q: missing release", s)
+	}
+	ver := strings.LastIndexByte(nvr[:rel], '-')
+	if ver < 0 {
+		return NEVRA{}, xerrors.Errorf("invalid NEVRA %q: missing version", s)
+	}
+	nevra.Name = nvr[:ver]
+	nevra.EVR = ParseEVR(nvr[ver+1:])
+
+	if prefix, name, ok := strings.Cut(nevra.Name, ":"); ok && nevra.Epoch == nil {
+		// "(none):" and "0:" are parsed as an epoch, anything else is not
+		if e := ParseEVR(prefix + ":"); e.Version == "" {
+			nevra.Name, nevra.Epoch = name, e.Epoch
+		}
+	}
+
+	if nevra.Name == "" || nevra.Version == "" || nevra.Release == "" || nevra.Arch == "" {
+		return NEVRA{}, xerrors.Errorf("invalid NEVRA %q", s)
+	}
+	return nevra, nil
+}
+
+// String formats the NEVRA as "name-[epoch:]version-release.arch", omitting a
+// missing epoch like rpm does.
+func (n NEVRA) String() string {
+	return fmt.Sprintf("%s-%s.%s", n.Name, n.EVR, n.Arch)
+}
+
+// Compare orders packages by name, then EVR, then architecture.
+func (n NEVRA) Compare(other NEVRA) int {
+	if rc := strings.Compare(n.Name, other.Name); rc != 0 {
+		return rc
+	}
+	if rc := n.EVR.Compare(other.EVR); rc != 0 {
+		return rc
+	}
+	return strings.Compare(n.Arch, other.Arch)
+}
+
+// EVR returns the epoch, version and release of the package.
+func (p *PackageInfo) EVR() EVR {
+	return EVR{Epoch: p.Epoch, Version: p.Version, Release: p.Release}
+}
+
+// NEVRA returns the name, epoch, version, release and architecture of the package.
+func (p *PackageInfo) NEVRA() NEVRA {
+	return NEVRA{Name: p.Name, EVR: p.EVR(), Arch: p.Arch}
+}
+
+// VersionCompare compares two version or release strings the way rpm does,
+// returning -1, 0 or +1. A '~' sorts before anything, even the end of the
+// string, and a '^' sorts after the end of the string but before anything else.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/rpmio/rpmvercmp.c
+func VersionCompare(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	one, two := a, b
+	for len(one) > 0 || len(two) > 0 {
+		one = strings.TrimLeftFunc(one, isSeparator)
+		two = strings.TrimLeftFunc(two, isSeparator)
+
+		// handle the tilde separator, it sorts before everything else
+		if strings.HasPrefix(one, "~") || strings.HasPrefix(two, "~") {
+			if !strings.HasPrefix(one, "~") {
+				return 1
+			}
+			if !strings.HasPrefix(two, "~") {
+				return -1
+			}
+			one, two = one[1:], two[1:]
+			continue
+		}
+
+		// the caret is like the tilde, except that if one of the strings ends
+		// (base version), the other is considered as higher version
+		if strings.HasPrefix(one, "^") || strings.HasPrefix(two, "^") {
+			if one == "" {
+				return -1
+			}
+			if two == "" {
+				return 1
+			}
+			if !strings.HasPrefix(one, "^") {
+				return 1
+			}
+			if !strings.HasPrefix(two, "^") {
+				return -1
+			}
+			one, two = one[1:], two[1:]
+			continue
+		}
+
+		// if we ran to the end of either, we are finished with the loop
+		if one == "" || two == "" {
+			break
+		}
+
+		// grab first completely alpha or completely numeric segment
+		isNum := isDigit(one[0])
+		segment := isAlpha
+		if isNum {
+			segment = isDigit
+		}
+		seg1, seg2 := leading(one, segment), leading(two, segment)
+		one, two = one[len(seg1):], two[len(seg2):]
+
+		// numeric segments are always newer than alpha segments
+		if seg2 == "" {
+			if isNum {
+				return 1
+			}
+			return -1
+		}
+
+		if isNum {
+			// throw away any leading zeros, whichever number has more digits wins
+			seg1 = strings.TrimLeft(seg1, "0")
+			seg2 = strings.TrimLeft(seg2, "0")
+			if rc := cmp.Compare(len(seg1), len(seg2)); rc != 0 {
+				return rc
+			}
+		}
+		if rc := strings.Compare(seg1, seg2); rc != 0 {
+			return rc
+		}
+	}
+
+	// whichever version still has characters left over wins
+	switch {
+	case one == "" && two == "":
+		return 0
+	case one == "":
+		return -1
+	default:
+		return 1
+	}
+}
+
+func isSeparator(r rune) bool {
+	return r > 0x7f || !isAlpha(byte(r)) && !isDigit(byte(r)) && r != '~' && r != '^'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func leading(s string, f func(byte) bool) string {
+	i := 0
+	for i < len(s) && f(s[i]) {
+		i++
+	}
+	return s[:i]
+}
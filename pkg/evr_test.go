@@ -0,0 +1,224 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/tests/rpmvercmp.at
+func TestVersionCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "2.0", -1},
+		{"2.0", "1.0", 1},
+		{"2.0.1", "2.0.1", 0},
+		{"2.0", "2.0.1", -1},
+		{"2.0.1", "2.0", 1},
+		{"2.0.1a", "2.0.1a", 0},
+		{"2.0.1a", "2.0.1", 1},
+		{"2.0.1", "2.0.1a", -1},
+		{"5.5p1", "5.5p1", 0},
+		{"5.5p1", "5.5p2", -1},
+		{"5.5p2", "5.5p1", 1},
+		{"5.5p10", "5.5p10", 0},
+		{"5.5p1", "5.5p10", -1},
+		{"5.5p10", "5.5p1", 1},
+		{"10xyz", "10.1xyz", -1},
+		{"10.1xyz", "10xyz", 1},
+		{"xyz10", "xyz10", 0},
+		{"xyz10", "xyz10.1", -1},
+		{"xyz10.1", "xyz10", 1},
+		{"xyz.4", "xyz.4", 0},
+		{"xyz.4", "8", -1},
+		{"8", "xyz.4", 1},
+		{"xyz.4", "2", -1},
+		{"2", "xyz.4", 1},
+		{"5.5p2", "5.6p1", -1},
+		{"5.6p1", "5.5p2", 1},
+		{"5.6p1", "6.5p1", -1},
+		{"6.5p1", "5.6p1", 1},
+		{"6.0.rc1", "6.0", 1},
+		{"6.0", "6.0.rc1", -1},
+		{"10b2", "10a1", 1},
+		{"10a2", "10b2", -1},
+		{"1.0aa", "1.0aa", 0},
+		{"1.0a", "1.0aa", -1},
+		{"1.0aa", "1.0a", 1},
+		{"10.0001", "10.0001", 0},
+		{"10.0001", "10.1", 0},
+		{"10.1", "10.0001", 0},
+		{"10.0001", "10.0039", -1},
+		{"10.0039", "10.0001", 1},
+		{"4.999.9", "5.0", -1},
+		{"5.0", "4.999.9", 1},
+		{"20101121", "20101121", 0},
+		{"20101121", "20101122", -1},
+		{"20101122", "20101121", 1},
+		{"2_0", "2_0", 0},
+		{"2.0", "2_0", 0},
+		{"2_0", "2.0", 0},
+		{"a", "a", 0},
+		{"a+", "a+", 0},
+		{"a+", "a_", 0},
+		{"a_", "a+", 0},
+		{"+a", "+a", 0},
+		{"+a", "_a", 0},
+		{"_a", "+a", 0},
+		{"+_", "+_", 0},
+		{"_+", "+_", 0},
+		{"_+", "_", 0},
+		{"+", "_", 0},
+		{"_", "+", 0},
+		{"1.0~rc1", "1.0~rc1", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0", "1.0~rc1", 1},
+		{"1.0~rc1", "1.0~rc2", -1},
+		{"1.0~rc2", "1.0~rc1", 1},
+		{"1.0~rc1~git123", "1.0~rc1~git123", 0},
+		{"1.0~rc1~git123", "1.0~rc1", -1},
+		{"1.0~rc1", "1.0~rc1~git123", 1},
+		{"1.0^", "1.0^", 0},
+		{"1.0^", "1.0", 1},
+		{"1.0", "1.0^", -1},
+		{"1.0^git1", "1.0^git1", 0},
+		{"1.0^git1", "1.0", 1},
+		{"1.0", "1.0^git1", -1},
+		{"1.0^git1", "1.0^git2", -1},
+		{"1.0^git2", "1.0^git1", 1},
+		{"1.0^git1", "1.01", -1},
+		{"1.01", "1.0^git1", 1},
+		{"1.0^20160101", "1.0^20160101", 0},
+		{"1.0^20160101", "1.0.1", -1},
+		{"1.0.1", "1.0^20160101", 1},
+		{"1.0^20160101^git1", "1.0^20160101^git1", 0},
+		{"1.0^20160102", "1.0^20160101^git1", 1},
+		{"1.0^20160101^git1", "1.0^20160102", -1},
+		{"1.0~rc1^git1", "1.0~rc1^git1", 0},
+		{"1.0~rc1^git1", "1.0~rc1", 1},
+		{"1.0~rc1", "1.0~rc1^git1", -1},
+		{"1.0^git1~pre", "1.0^git1~pre", 0},
+		{"1.0^git1", "1.0^git1~pre", 1},
+		{"1.0^git1~pre", "1.0^git1", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, VersionCompare(tt.a, tt.b))
+		})
+	}
+}
+
+func TestParseEVR(t *testing.T) {
+	tests := []struct {
+		in   string
+		want EVR
+		str  string
+	}{
+		{in: "1.0-1", want: EVR{Version: "1.0", Release: "1"}, str: "1.0-1"},
+		{in: "2:1.0-1.el8", want: EVR{Epoch: intRef(2), Version: "1.0", Release: "1.el8"}, str: "2:1.0-1.el8"},
+		{in: "0:1.0", want: EVR{Epoch: intRef(0), Version: "1.0"}, str: "0:1.0"},
+		{in: "1.0", want: EVR{Version: "1.0"}, str: "1.0"},
+		{in: "(none):1.0-1", want: EVR{Version: "1.0", Release: "1"}, str: "1.0-1"},
+		{in: ":1.0", want: EVR{Epoch: intRef(0), Version: "1.0"}, str: "0:1.0"},
+		{in: "1.0~rc1-0.1", want: EVR{Version: "1.0~rc1", Release: "0.1"}, str: "1.0~rc1-0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got := ParseEVR(tt.in)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.str, got.String())
+		})
+	}
+}
+
+func TestEVR_Compare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.0-1", b: "1.0-1", want: 0},
+		{a: "1:1.0-1", b: "2.0-1", want: 1},
+		{a: "0:1.0-1", b: "1.0-1", want: 0},
+		{a: "1.0-2", b: "1.0-10", want: -1},
+		{a: "1.0", b: "1.0-10", want: 0},
+		{a: "1.0~rc1-1", b: "1.0-1", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseEVR(tt.a).Compare(ParseEVR(tt.b)))
+			assert.Equal(t, -tt.want, ParseEVR(tt.b).Compare(ParseEVR(tt.a)))
+		})
+	}
+}
+
+func TestParseNEVRA(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    NEVRA
+		str     string
+		wantErr string
+	}{
+		{
+			in:   "bash-4.4.20-4.el8_6.x86_64",
+			want: NEVRA{Name: "bash", EVR: EVR{Version: "4.4.20", Release: "4.el8_6"}, Arch: "x86_64"},
+			str:  "bash-4.4.20-4.el8_6.x86_64",
+		},
+		{
+			in:   "openssl-libs-1:3.0.7-18.el9_2.x86_64",
+			want: NEVRA{Name: "openssl-libs", EVR: EVR{Epoch: intRef(1), Version: "3.0.7", Release: "18.el9_2"}, Arch: "x86_64"},
+			str:  "openssl-libs-1:3.0.7-18.el9_2.x86_64",
+		},
+		{
+			in:   "1:openssl-libs-3.0.7-18.el9_2.x86_64",
+			want: NEVRA{Name: "openssl-libs", EVR: EVR{Epoch: intRef(1), Version: "3.0.7", Release: "18.el9_2"}, Arch: "x86_64"},
+			str:  "openssl-libs-1:3.0.7-18.el9_2.x86_64",
+		},
+		{
+			in:   "gpg-pubkey-(none):fd431d51-4ae0493b.(none)",
+			want: NEVRA{Name: "gpg-pubkey", EVR: EVR{Version: "fd431d51", Release: "4ae0493b"}, Arch: "(none)"},
+			str:  "gpg-pubkey-fd431d51-4ae0493b.(none)",
+		},
+		{
+			in:      "bash-4.4.20",
+			wantErr: "invalid NEVRA",
+		},
+		{
+			in:      "bash.x86_64",
+			wantErr: "missing release",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseNEVRA(tt.in)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.str, got.String())
+		})
+	}
+}
+
+func TestPackageInfo_NEVRA(t *testing.T) {
+	db, err := Open("testdata/libuuid/Packages")
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkg, err := db.Package("libuuid")
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.32.1-42.el8_8", pkg.EVR().String())
+	assert.Equal(t, "libuuid-2.32.1-42.el8_8.x86_64", pkg.NEVRA().String())
+
+	nevra, err := ParseNEVRA(pkg.NEVRA().String())
+	require.NoError(t, err)
+	assert.Equal(t, 0, nevra.Compare(pkg.NEVRA()))
+	assert.Equal(t, -1, nevra.Compare(NEVRA{Name: "libuuid", EVR: ParseEVR("2.32.1-43.el8"), Arch: "x86_64"}))
+}
@@ -0,0 +1,165 @@
+package rpmdb
+
+import (
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// source: https://github.com/torvalds/linux/blob/v6.6/include/uapi/linux/capability.h
+var capNames = []string{
+	"cap_chown", "cap_dac_override", "cap_dac_read_search", "cap_fowner", "cap_fsetid",
+	"cap_kill", "cap_setgid", "cap_setuid", "cap_setpcap", "cap_linux_immutable",
+	"cap_net_bind_service", "cap_net_broadcast", "cap_net_admin", "cap_net_raw",
+	"cap_ipc_lock", "cap_ipc_owner", "cap_sys_module", "cap_sys_rawio", "cap_sys_chroot",
+	"cap_sys_ptrace", "cap_sys_pacct", "cap_sys_admin", "cap_sys_boot", "cap_sys_nice",
+	"cap_sys_resource", "cap_sys_time", "cap_sys_tty_config", "cap_mknod", "cap_lease",
+	"cap_audit_write", "cap_audit_control", "cap_setfcap", "cap_mac_override",
+	"cap_mac_admin", "cap_syslog", "cap_wake_alarm", "cap_block_suspend", "cap_audit_read",
+	"cap_perfmon", "cap_bpf", "cap_checkpoint_restore",
+}
+
+const (
+	vfsCapRevisionMask   = 0xff000000
+	vfsCapRevision1      = 0x01000000
+	vfsCapRevision2      = 0x02000000
+	vfsCapRevision3      = 0x03000000
+	vfsCapFlagsEffective = 0x000001
+)
+
+// fileCaps is the capability sets of a file, one bit per capability.
+type fileCaps struct {
+	effective   uint64
+	permitted   uint64
+	inheritable uint64
+}
+
+// parseCapsText parses the textual form of RPMTAG_FILECAPS, e.g. "cap_net_raw=ep",
+// the way libcap's cap_from_text does.
+// ref. https://man7.org/linux/man-pages/man3/cap_from_text.3.html
+func parseCapsText(s string) (fileCaps, error) {
+	allCaps := uint64(1)<<len(capNames) - 1
+
+	var caps fileCaps
+	for _, clause := range strings.Fields(s) {
+		i := strings.IndexAny(clause, "=+-")
+		if i < 0 {
+			return fileCaps{}, xerrors.Errorf("invalid capability clause: %s", clause)
+		}
+
+		var list uint64
+		if clause[:i] == "" {
+			// "=ep" refers to all capabilities
+			if clause[i] != '=' {
+				return fileCaps{}, xerrors.Errorf("invalid capability clause: %s", clause)
+			}
+			list = allCaps
+		}
+		for _, name := range strings.Split(clause[:i], ",") {
+			if name == "" {
+				continue
+			}
+			bit, err := capNumber(strings.ToLower(name))
+			if err != nil {
+				return fileCaps{}, err
+			}
+			if bit < 0 {
+				list |= allCaps
+				continue
+			}
+			list |= 1 << bit
+		}
+
+		// several operators may follow the list, e.g. "cap_kill=ep-i"
+		for rest := clause[i:]; rest != ""; {
+			op := rest[0]
+			if op != '=' && op != '+' && op != '-' {
+				return fileCaps{}, xerrors.Errorf("invalid capability clause: %s", clause)
+			}
+			j := 1
+			for j < len(rest) && strings.IndexByte("eip", rest[j]) >= 0 {
+				j++
+			}
+			flags := rest[1:j]
+			rest = rest[j:]
+
+			if op == '=' {
+				caps.effective &^= list
+				caps.permitted &^= list
+				caps.inheritable &^= list
+			} else if flags == "" {
+				return fileCaps{}, xerrors.Errorf("invalid capability clause: %s", clause)
+			}
+			for _, flag := range flags {
+				set := &caps.effective
+				switch flag {
+				case 'p':
+					set = &caps.permitted
+				case 'i':
+					set = &caps.inheritable
+				}
+				if op == '-' {
+					*set &^= list
+				} else {
+					*set |= list
+				}
+			}
+		}
+	}
+	return caps, nil
+}
+
+// capNumber returns the bit of the named capability, or -1 for "all".
+func capNumber(name string) (int, error) {
+	if name == "all" {
+		return -1, nil
+	}
+	for i, capName := range capNames {
+		if name == capName {
+			return i, nil
+		}
+	}
+	if n, err := strconv.Atoi(name); err == nil && n >= 0 && n < 64 {
+		return n, nil
+	}
+	return 0, xerrors.Errorf("unknown capability: %s", name)
+}
+
+// parseVFSCaps decodes the security.capability extended attribute of a file. The
+// kernel raises the permitted and inheritable capabilities into the effective set
+// if the effective flag is set, which is how libcap reports it as well.
+// ref. https://github.com/torvalds/linux/blob/v6.6/include/uapi/linux/capability.h#L55-L90
+func parseVFSCaps(data []byte) (fileCaps, error) {
+	if len(data) == 0 {
+		return fileCaps{}, nil
+	}
+	if len(data) < 4 {
+		return fileCaps{}, xerrors.Errorf("short capability attribute: %d bytes", len(data))
+	}
+
+	magic := binary.LittleEndian.Uint32(data)
+	var n int
+	switch magic & vfsCapRevisionMask {
+	case vfsCapRevision1:
+		n = 1
+	case vfsCapRevision2, vfsCapRevision3:
+		n = 2
+	default:
+		return fileCaps{}, xerrors.Errorf("unknown capability revision: %#x", magic&vfsCapRevisionMask)
+	}
+	if len(data) < 4+8*n {
+		return fileCaps{}, xerrors.Errorf("short capability attribute: %d bytes", len(data))
+	}
+
+	var caps fileCaps
+	for i := 0; i < n; i++ {
+		caps.permitted |= uint64(binary.LittleEndian.Uint32(data[4+8*i:])) << (32 * i)
+		caps.inheritable |= uint64(binary.LittleEndian.Uint32(data[8+8*i:])) << (32 * i)
+	}
+	if magic&vfsCapFlagsEffective != 0 {
+		caps.effective = caps.permitted | caps.inheritable
+	}
+	return caps, nil
+}
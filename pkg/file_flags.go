@@ -17,6 +17,13 @@ const (
 	RPMFILE_ARTIFACT                    /*!< from %%artifact */
 )
 
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/build/rpmfc.h#L16-L20
+const (
+	RPMFC_ELF32      int32 = 1 << iota /*!< 32-bit ELF */
+	RPMFC_ELF64                        /*!< 64-bit ELF */
+	RPMFC_ELFMIPSN32                   /*!< MIPS n32 ELF */
+)
+
 var flagChar = map[int32]string{
 	RPMFILE_CONFIG:    "c",
 	RPMFILE_DOC:       "d",
@@ -0,0 +1,157 @@
+package rpmdb
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+
+	"golang.org/x/xerrors"
+)
+
+// source: https://github.com/torvalds/linux/blob/v6.6/include/uapi/linux/hash_info.h
+
+type IMAHashAlgo uint8
+
+const (
+	HASH_ALGO_MD4 IMAHashAlgo = iota
+	HASH_ALGO_MD5
+	HASH_ALGO_SHA1
+	HASH_ALGO_RIPE_MD_160
+	HASH_ALGO_SHA256
+	HASH_ALGO_SHA384
+	HASH_ALGO_SHA512
+	HASH_ALGO_SHA224
+	HASH_ALGO_RIPE_MD_128
+	HASH_ALGO_RIPE_MD_256
+	HASH_ALGO_RIPE_MD_320
+	HASH_ALGO_WP_256
+	HASH_ALGO_WP_384
+	HASH_ALGO_WP_512
+	HASH_ALGO_TGR_128
+	HASH_ALGO_TGR_160
+	HASH_ALGO_TGR_192
+	HASH_ALGO_SM3_256
+	HASH_ALGO_STREEBOG_256
+	HASH_ALGO_STREEBOG_512
+	HASH_ALGO_SHA3_256
+	HASH_ALGO_SHA3_384
+	HASH_ALGO_SHA3_512
+)
+
+// source: https://github.com/torvalds/linux/blob/v6.6/crypto/hash_info.c
+var imaHashAlgoNames = []string{
+	"md4", "md5", "sha1", "rmd160", "sha256", "sha384", "sha512", "sha224",
+	"rmd128", "rmd256", "rmd320", "wp256", "wp384", "wp512", "tgr128", "tgr160",
+	"tgr192", "sm3", "streebog256", "streebog512", "sha3-256", "sha3-384", "sha3-512",
+}
+
+func (a IMAHashAlgo) String() string {
+	if int(a) < len(imaHashAlgoNames) {
+		return imaHashAlgoNames[a]
+	}
+	return "unknown-hash-algorithm"
+}
+
+// source: https://github.com/torvalds/linux/blob/v6.6/security/integrity/integrity.h#L19-L30
+const (
+	EVM_IMA_XATTR_DIGSIG = 0x03
+
+	imaSignatureHeaderSize = 9 // sizeof(struct signature_v2_hdr)
+)
+
+// IMASignature is the security.ima extended attribute rpm's ima plugin sets on an
+// installed file.
+// ref. https://github.com/torvalds/linux/blob/v6.6/security/integrity/integrity.h#L111-L118
+type IMASignature struct {
+	// Raw is the value of the extended attribute.
+	Raw      []byte
+	Type     uint8 // EVM_IMA_XATTR_DIGSIG
+	Version  uint8 // 2, or 3 for a signature over the digest of a struct ima_file_id
+	HashAlgo IMAHashAlgo
+	// KeyID is the last 4 bytes of the subject key identifier of the signing
+	// certificate, as printed by evmctl.
+	KeyID     uint32
+	Signature []byte
+}
+
+// KeyIDString returns KeyID as 8 hex digits.
+func (s *IMASignature) KeyIDString() string {
+	return hex.EncodeToString(binary.BigEndian.AppendUint32(nil, s.KeyID))
+}
+
+// parseIMASignature decodes an entry of RPMTAG_FILESIGNATURES, the hex encoded
+// extended attribute. An empty entry is an unsigned file and returns nil.
+func parseIMASignature(s string) (*IMASignature, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid IMA signature encoding: %w", err)
+	}
+	if len(raw) < imaSignatureHeaderSize {
+		return nil, xerrors.Errorf("short IMA signature: %d bytes", len(raw))
+	}
+	if raw[0] != EVM_IMA_XATTR_DIGSIG {
+		return nil, xerrors.Errorf("unexpected IMA signature type: %d", raw[0])
+	}
+
+	size := int(binary.BigEndian.Uint16(raw[7:]))
+	if imaSignatureHeaderSize+size != len(raw) {
+		return nil, xerrors.Errorf("invalid IMA signature size: %d", size)
+	}
+	return &IMASignature{
+		Raw:       raw,
+		Type:      raw[0],
+		Version:   raw[1],
+		HashAlgo:  IMAHashAlgo(raw[2]),
+		KeyID:     binary.BigEndian.Uint32(raw[3:]),
+		Signature: raw[imaSignatureHeaderSize:],
+	}, nil
+}
+
+// source: https://github.com/torvalds/linux/blob/v6.6/include/uapi/linux/fsverity.h#L17-L18
+
+type VerityHashAlgo int32
+
+const (
+	FS_VERITY_HASH_ALG_SHA256 VerityHashAlgo = 1
+	FS_VERITY_HASH_ALG_SHA512 VerityHashAlgo = 2
+)
+
+func (a VerityHashAlgo) String() string {
+	switch a {
+	case FS_VERITY_HASH_ALG_SHA256:
+		return "sha256"
+	case FS_VERITY_HASH_ALG_SHA512:
+		return "sha512"
+	default:
+		return "unknown-hash-algorithm"
+	}
+}
+
+// VeritySignature is the fs-verity built-in signature rpm's fsverity plugin
+// enables an installed file with.
+type VeritySignature struct {
+	HashAlgo VerityHashAlgo
+	// Signature is a DER encoded PKCS#7 signature of the fs-verity digest.
+	Signature []byte
+}
+
+// parseVeritySignature decodes an entry of RPMTAG_VERITYSIGNATURES, which is base64
+// encoded. An empty entry is an unsigned file and returns nil.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.17.0-release/plugins/fsverity.c
+func parseVeritySignature(s string, algo VerityHashAlgo) (*VeritySignature, error) {
+	if s == "" {
+		return nil, nil
+	}
+	sig, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid fs-verity signature encoding: %w", err)
+	}
+	if algo == 0 {
+		// rpm defaults to SHA256 when the tag is missing
+		algo = FS_VERITY_HASH_ALG_SHA256
+	}
+	return &VeritySignature{HashAlgo: algo, Signature: sig}, nil
+}
@@ -0,0 +1,124 @@
+package rpmdb
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseIMASignature(t *testing.T) {
+	tests := []struct {
+		name    string
+		sig     string
+		want    *IMASignature
+		wantErr string
+	}{
+		{
+			name: "sha256 signature",
+			sig:  "030204a1b2c3d40004deadbeef",
+			want: &IMASignature{
+				Raw:       []byte{0x03, 0x02, 0x04, 0xa1, 0xb2, 0xc3, 0xd4, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
+				Type:      EVM_IMA_XATTR_DIGSIG,
+				Version:   2,
+				HashAlgo:  HASH_ALGO_SHA256,
+				KeyID:     0xa1b2c3d4,
+				Signature: []byte{0xde, 0xad, 0xbe, 0xef},
+			},
+		},
+		{
+			name: "unsigned file",
+			sig:  "",
+		},
+		{
+			name:    "invalid hex",
+			sig:     "0302zz",
+			wantErr: "invalid IMA signature encoding",
+		},
+		{
+			name:    "short header",
+			sig:     "030204a1b2",
+			wantErr: "short IMA signature",
+		},
+		{
+			name:    "digest instead of signature",
+			sig:     "040204a1b2c3d40004deadbeef",
+			wantErr: "unexpected IMA signature type: 4",
+		},
+		{
+			name:    "truncated signature",
+			sig:     "030204a1b2c3d40005deadbeef",
+			wantErr: "invalid IMA signature size: 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseIMASignature(tt.sig)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIMASignature_String(t *testing.T) {
+	sig, err := parseIMASignature("030206000000ff0000")
+	require.NoError(t, err)
+	assert.Equal(t, "000000ff", sig.KeyIDString())
+	assert.Equal(t, "sha512", sig.HashAlgo.String())
+	assert.Equal(t, "unknown-hash-algorithm", IMAHashAlgo(200).String())
+}
+
+func TestPackageInfo_InstalledFiles_signatures(t *testing.T) {
+	// sign the first file of bash, /bin/bash
+	imaSigs := make([]string, 67)
+	imaSigs[0] = "030204a1b2c3d40004deadbeef"
+	imaSigs[1] = "050204a1b2c3d40004deadbeef" // not EVM_IMA_XATTR_DIGSIG
+	veritySigs := make([]string, 67)
+	veritySigs[0] = base64.StdEncoding.EncodeToString([]byte{0x30, 0x82})
+	veritySigs[2] = "not base64"
+	stringArray := func(s []string) []byte { return []byte(strings.Join(s, "\x00") + "\x00") }
+
+	file := resignBash(t, func(blob []byte) []byte {
+		return signHeader(t, blob,
+			headerTag{RPMTAG_FILESIGNATURES, RPM_STRING_ARRAY_TYPE, stringArray(imaSigs)},
+			headerTag{RPMTAG_VERITYSIGNATURES, RPM_STRING_ARRAY_TYPE, stringArray(veritySigs)},
+		)
+	})
+
+	db, err := Open(file)
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkg, err := db.Package("bash")
+	require.NoError(t, err)
+	files, err := pkg.InstalledFiles()
+	require.NoError(t, err)
+	require.Len(t, files, 67)
+
+	assert.Equal(t, "/bin/bash", files[0].Path)
+	require.NotNil(t, files[0].IMASignature)
+	assert.Equal(t, HASH_ALGO_SHA256, files[0].IMASignature.HashAlgo)
+	assert.Equal(t, "a1b2c3d4", files[0].IMASignature.KeyIDString())
+	assert.Equal(t, &VeritySignature{HashAlgo: FS_VERITY_HASH_ALG_SHA256, Signature: []byte{0x30, 0x82}}, files[0].VeritySignature)
+	assert.NoError(t, files[0].IMASignatureErr)
+	assert.NoError(t, files[0].VeritySignatureErr)
+
+	// malformed signatures are reported on their file only
+	assert.Nil(t, files[1].IMASignature)
+	assert.ErrorContains(t, files[1].IMASignatureErr, "unexpected IMA signature type")
+	assert.Nil(t, files[2].VeritySignature)
+	assert.ErrorContains(t, files[2].VeritySignatureErr, "invalid fs-verity signature encoding")
+
+	for _, f := range files[3:] {
+		assert.Nil(t, f.IMASignature, f.Path)
+		assert.Nil(t, f.VeritySignature, f.Path)
+		assert.NoError(t, f.IMASignatureErr, f.Path)
+		assert.NoError(t, f.VeritySignatureErr, f.Path)
+	}
+}
@@ -0,0 +1,145 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/knqyf263/go-rpmdb/pkg/bdb"
+	"github.com/knqyf263/go-rpmdb/pkg/ndb"
+	"github.com/knqyf263/go-rpmdb/pkg/sqlite3"
+	"golang.org/x/xerrors"
+)
+
+// Format identifies the storage backend of an rpmdb.
+type Format int
+
+const (
+	FormatUnknown         Format = iota
+	FormatBerkeleyDB             // Berkeley DB hash database, "Packages"
+	FormatNDB                    // rpm's native database, "Packages.db"
+	FormatSQLite                 // SQLite3 database, "rpmdb.sqlite"
+	FormatBerkeleyDBBtree        // Berkeley DB btree database, used by the secondary indexes
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatBerkeleyDB:
+		return "bdb"
+	case FormatNDB:
+		return "ndb"
+	case FormatSQLite:
+		return "sqlite"
+	case FormatBerkeleyDBBtree:
+		return "bdb-btree"
+	default:
+		return "unknown"
+	}
+}
+
+// DBInfo describes a database file. Only the metadata of the detected format is set.
+type DBInfo struct {
+	Format     Format
+	BerkeleyDB *BerkeleyDBInfo
+	NDB        *NDBInfo
+	SQLite     *SQLiteInfo
+}
+
+type BerkeleyDBInfo struct {
+	Version    uint32
+	PageSize   uint32
+	ByteOrder  binary.ByteOrder
+	LastPageNo uint32
+}
+
+type NDBInfo struct {
+	Version    uint32
+	Generation uint32
+	SlotPages  uint32
+}
+
+type SQLiteInfo struct {
+	UserVersion int32
+	PageSize    uint32
+}
+
+// Detect reports the format of the database file at path along with the metadata
+// found in its header. Files of no known format are reported as FormatUnknown.
+func Detect(path string) (*DBInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return DetectReaderAt(f)
+}
+
+// DetectReaderAt is like Detect for a database held in r.
+func DetectReaderAt(r io.ReaderAt) (*DBInfo, error) {
+	// the largest header we look at is the Berkeley DB generic metadata
+	buff := make([]byte, 512)
+	n, err := r.ReadAt(buff, 0)
+	if err != nil && err != io.EOF {
+		return nil, xerrors.Errorf("failed to read header: %w", err)
+	}
+	buff = buff[:n]
+
+	if info := detectSQLite(buff); info != nil {
+		return &DBInfo{Format: FormatSQLite, SQLite: info}, nil
+	}
+
+	if md, err := ndb.ReadMetadata(bytes.NewReader(buff)); err == nil {
+		return &DBInfo{
+			Format: FormatNDB,
+			NDB: &NDBInfo{
+				Version:    md.Version,
+				Generation: md.Generation,
+				SlotPages:  md.SlotPages,
+			},
+		}, nil
+	}
+
+	if md, err := bdb.ParseMetadataPage(buff); err == nil {
+		info := &DBInfo{
+			BerkeleyDB: &BerkeleyDBInfo{
+				Version:    md.Version,
+				PageSize:   md.PageSize,
+				ByteOrder:  binary.LittleEndian,
+				LastPageNo: md.LastPageNo,
+			},
+		}
+		if md.Swapped {
+			info.BerkeleyDB.ByteOrder = binary.BigEndian
+		}
+
+		switch md.PageType {
+		case bdb.HashMetadataPageType:
+			info.Format = FormatBerkeleyDB
+			return info, nil
+		case bdb.BtreeMetadataPageType:
+			info.Format = FormatBerkeleyDBBtree
+			return info, nil
+		}
+	}
+
+	return &DBInfo{Format: FormatUnknown}, nil
+}
+
+// ref. https://www.sqlite.org/fileformat.html#the_database_header
+func detectSQLite(header []byte) *SQLiteInfo {
+	const headerSize = 100
+	if len(header) < headerSize || !bytes.Equal(header[:16], sqlite3.SQLite3_HeaderMagic) {
+		return nil
+	}
+
+	pageSize := uint32(binary.BigEndian.Uint16(header[16:18]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+	return &SQLiteInfo{
+		UserVersion: int32(binary.BigEndian.Uint32(header[60:64])),
+		PageSize:    pageSize,
+	}
+}
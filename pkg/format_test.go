@@ -0,0 +1,95 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want *DBInfo
+	}{
+		{
+			name: "BerkeleyDB",
+			file: "testdata/libuuid/Packages",
+			want: &DBInfo{
+				Format: FormatBerkeleyDB,
+				BerkeleyDB: &BerkeleyDBInfo{
+					Version:    9,
+					PageSize:   4096,
+					ByteOrder:  binary.LittleEndian,
+					LastPageNo: 22,
+				},
+			},
+		},
+		{
+			name: "NDB",
+			file: "testdata/sle15-bci/Packages.db",
+			want: &DBInfo{
+				Format: FormatNDB,
+				NDB: &NDBInfo{
+					Version:    0,
+					Generation: 46,
+					SlotPages:  1,
+				},
+			},
+		},
+		{
+			name: "SQLite3",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			want: &DBInfo{
+				Format: FormatSQLite,
+				SQLite: &SQLiteInfo{
+					UserVersion: 0,
+					PageSize:    4096,
+				},
+			},
+		},
+		{
+			name: "not a database",
+			file: "testdata/blob.bin",
+			want: &DBInfo{
+				Format: FormatUnknown,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Detect(tt.file)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDetectReaderAt_btree(t *testing.T) {
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		t.Run(order.String(), func(t *testing.T) {
+			// generic metadata page of a btree database, e.g. /var/lib/rpm/Name
+			page := make([]byte, 512)
+			order.PutUint32(page[12:], 0x00053162)
+			order.PutUint32(page[16:], 9)
+			order.PutUint32(page[20:], 4096)
+			page[25] = 9
+			order.PutUint32(page[32:], 3)
+
+			got, err := DetectReaderAt(bytes.NewReader(page))
+			require.NoError(t, err)
+			assert.Equal(t, &DBInfo{
+				Format: FormatBerkeleyDBBtree,
+				BerkeleyDB: &BerkeleyDBInfo{
+					Version:    9,
+					PageSize:   4096,
+					ByteOrder:  order,
+					LastPageNo: 3,
+				},
+			}, got)
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package rpmdb
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// Graph is the dependency graph of the installed packages. There is an edge from
+// a package to every other package providing one of its requirements. A rich
+// requirement links to the providers of the operands it actually requires: all
+// those of "and" and "with", the satisfied alternatives of "or" and the branch
+// selected by "if" and "unless", but never their conditions.
+type Graph struct {
+	// Packages are the nodes of the graph in database order.
+	Packages []*PackageInfo
+
+	nodes map[*PackageInfo]int
+	out   [][]graphEdge // out[i] are the dependencies of Packages[i]
+	in    [][]int       // in[i] are the dependents of Packages[i]
+}
+
+type graphEdge struct {
+	to       int
+	requires []Dependency // the requirements resolved by the edge
+}
+
+// Graph builds the dependency graph of the installed packages. rpmlib()
+// requirements and Requires(hint) are ignored like in CheckDependencies.
+func (d *RpmDB) Graph() (*Graph, error) {
+	return d.GraphContext(context.Background())
+}
+
+// GraphContext is like Graph, but gives up once ctx is done.
+func (d *RpmDB) GraphContext(ctx context.Context) (*Graph, error) {
+	idx, err := d.packageIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newGraph(idx)
+}
+
+func newGraph(idx *packageIndex) (*Graph, error) {
+	g := &Graph{
+		Packages: slices.Clone(idx.packages), // the index is shared by later queries
+		nodes:    make(map[*PackageInfo]int, len(idx.packages)),
+		out:      make([][]graphEdge, len(idx.packages)),
+		in:       make([][]int, len(idx.packages)),
+	}
+	for i, pkg := range idx.packages {
+		g.nodes[pkg] = i
+	}
+
+	// conditions are evaluated like in CheckDependencies
+	match := func(dep Dependency) bool { return len(idx.whatProvides(dep)) > 0 }
+	for i, pkg := range idx.packages {
+		requires, err := pkg.RequireDependencies()
+		if err != nil {
+			return nil, err
+		}
+		edges := make(map[int]int) // node => position in g.out[i]
+		for _, req := range requires {
+			if ignoredRequirement(req) {
+				continue
+			}
+			var providers []*PackageInfo
+			for _, dep := range req.required(match) {
+				providers = append(providers, idx.whatProvides(dep, pkg)...)
+			}
+			for _, provider := range uniquePackages(providers) {
+				j := g.nodes[provider]
+				if k, ok := edges[j]; ok {
+					g.out[i][k].requires = append(g.out[i][k].requires, req)
+					continue
+				}
+				edges[j] = len(g.out[i])
+				g.out[i] = append(g.out[i], graphEdge{to: j, requires: []Dependency{req}})
+				g.in[j] = append(g.in[j], i)
+			}
+		}
+	}
+	return g, nil
+}
+
+// Dependencies returns the packages pkg directly requires.
+func (g *Graph) Dependencies(pkg *PackageInfo) []*PackageInfo {
+	i, ok := g.nodes[pkg]
+	if !ok {
+		return nil
+	}
+	var pkgs []*PackageInfo
+	for _, e := range g.out[i] {
+		pkgs = append(pkgs, g.Packages[e.to])
+	}
+	return pkgs
+}
+
+// Dependents returns the packages directly requiring pkg.
+func (g *Graph) Dependents(pkg *PackageInfo) []*PackageInfo {
+	i, ok := g.nodes[pkg]
+	if !ok {
+		return nil
+	}
+	return g.packages(g.in[i])
+}
+
+// Requirements returns the requirements of pkg that dependency provides, which
+// tells why pkg pulls in dependency.
+func (g *Graph) Requirements(pkg, dependency *PackageInfo) []Dependency {
+	i, ok := g.nodes[pkg]
+	if !ok {
+		return nil
+	}
+	for _, e := range g.out[i] {
+		if g.Packages[e.to] == dependency {
+			return e.requires
+		}
+	}
+	return nil
+}
+
+// Leaves returns the packages no other package requires, like dnf leaves. These
+// are the packages that were installed on purpose or are left over.
+func (g *Graph) Leaves() []*PackageInfo {
+	return g.filter(func(i int) bool { return len(g.in[i]) == 0 })
+}
+
+// Roots returns the packages requiring no other package, e.g. filesystem.
+func (g *Graph) Roots() []*PackageInfo {
+	return g.filter(func(i int) bool { return len(g.out[i]) == 0 })
+}
+
+// Orphans returns the packages that neither require nor are required by any
+// other package.
+func (g *Graph) Orphans() []*PackageInfo {
+	return g.filter(func(i int) bool { return len(g.in[i]) == 0 && len(g.out[i]) == 0 })
+}
+
+// TransitiveDependencies returns every package pkg requires, directly or not.
+func (g *Graph) TransitiveDependencies(pkg *PackageInfo) []*PackageInfo {
+	return g.closure(pkg, func(i int) []int {
+		var next []int
+		for _, e := range g.out[i] {
+			next = append(next, e.to)
+		}
+		return next
+	})
+}
+
+// TransitiveDependents returns every package requiring pkg, directly or not.
+// These are the packages keeping pkg installed.
+func (g *Graph) TransitiveDependents(pkg *PackageInfo) []*PackageInfo {
+	return g.closure(pkg, func(i int) []int { return g.in[i] })
+}
+
+func (g *Graph) closure(pkg *PackageInfo, next func(int) []int) []*PackageInfo {
+	start, ok := g.nodes[pkg]
+	if !ok {
+		return nil
+	}
+	visited := make([]bool, len(g.Packages))
+	visited[start] = true
+	queue := []int{start}
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		for _, j := range next(i) {
+			if !visited[j] {
+				visited[j] = true
+				queue = append(queue, j)
+			}
+		}
+	}
+	visited[start] = false
+	return g.filter(func(i int) bool { return visited[i] })
+}
+
+// Cycles returns the dependency cycles, i.e. the strongly connected components
+// with more than one package. Packages in a cycle can only be removed together.
+func (g *Graph) Cycles() [][]*PackageInfo {
+	var cycles [][]*PackageInfo
+	for _, scc := range g.stronglyConnectedComponents() {
+		if len(scc) > 1 {
+			slices.Sort(scc)
+			cycles = append(cycles, g.packages(scc))
+		}
+	}
+	return cycles
+}
+
+// stronglyConnectedComponents implements Tarjan's algorithm. The components are
+// returned in reverse topological order, dependencies first.
+func (g *Graph) stronglyConnectedComponents() [][]int {
+	var (
+		index   = make([]int, len(g.Packages)) // 0 means unvisited
+		lowlink = make([]int, len(g.Packages))
+		onStack = make([]bool, len(g.Packages))
+		stack   []int
+		counter int
+		sccs    [][]int
+	)
+
+	var visit func(v int)
+	visit = func(v int) {
+		counter++
+		index[v], lowlink[v] = counter, counter
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, e := range g.out[v] {
+			w := e.to
+			if index[w] == 0 {
+				visit(w)
+				lowlink[v] = min(lowlink[v], lowlink[w])
+			} else if onStack[w] {
+				lowlink[v] = min(lowlink[v], index[w])
+			}
+		}
+
+		if lowlink[v] == index[v] {
+			var scc []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				scc = append(scc, w)
+				if w == v {
+					break
+				}
+			}
+			sccs = append(sccs, scc)
+		}
+	}
+
+	for v := range g.Packages {
+		if index[v] == 0 {
+			visit(v)
+		}
+	}
+	return sccs
+}
+
+func (g *Graph) filter(f func(int) bool) []*PackageInfo {
+	var pkgs []*PackageInfo
+	for i, pkg := range g.Packages {
+		if f(i) {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	return pkgs
+}
+
+func (g *Graph) packages(nodes []int) []*PackageInfo {
+	var pkgs []*PackageInfo
+	for _, i := range nodes {
+		pkgs = append(pkgs, g.Packages[i])
+	}
+	return pkgs
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language, with the packages
+// named by their NEVRA, e.g. for dot -Tsvg.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph rpmdb {")
+	for i, pkg := range g.Packages {
+		fmt.Fprintf(bw, "\t%s;\n", strconv.Quote(pkg.NEVRA().String()))
+		for _, e := range g.out[i] {
+			fmt.Fprintf(bw, "\t%s -> %s;\n", strconv.Quote(pkg.NEVRA().String()),
+				strconv.Quote(g.Packages[e.to].NEVRA().String()))
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+type jsonGraph struct {
+	Packages []string   `json:"packages"`
+	Edges    []jsonEdge `json:"edges"`
+}
+
+type jsonEdge struct {
+	From     string   `json:"from"`
+	To       string   `json:"to"`
+	Requires []string `json:"requires"`
+}
+
+// MarshalJSON encodes the graph as the NEVRAs of the packages and the edges
+// between them, with the requirements each edge resolves.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	jg := jsonGraph{Packages: []string{}, Edges: []jsonEdge{}}
+	for i, pkg := range g.Packages {
+		from := pkg.NEVRA().String()
+		jg.Packages = append(jg.Packages, from)
+		for _, e := range g.out[i] {
+			edge := jsonEdge{From: from, To: g.Packages[e.to].NEVRA().String()}
+			for _, req := range e.requires {
+				edge.Requires = append(edge.Requires, req.String())
+			}
+			jg.Edges = append(jg.Edges, edge)
+		}
+	}
+	return json.Marshal(jg)
+}
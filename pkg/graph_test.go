@@ -0,0 +1,143 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRpmDB_Graph(t *testing.T) {
+	db, err := Open("testdata/sle15-bci/Packages.db")
+	require.NoError(t, err)
+	defer db.Close()
+
+	g, err := db.Graph()
+	require.NoError(t, err)
+
+	names := func(pkgs []*PackageInfo) []string {
+		var got []string
+		for _, pkg := range pkgs {
+			got = append(got, pkg.Name)
+		}
+		return got
+	}
+	assert.Equal(t, []string{"sles-release", "ca-certificates-mozilla-prebuilt"}, names(g.Leaves()))
+	assert.Equal(t, []string{"system-user-root", "file-magic"}, names(g.Roots()))
+	assert.Empty(t, g.Orphans())
+
+	var cycles [][]string
+	for _, c := range g.Cycles() {
+		cycles = append(cycles, names(c))
+	}
+	assert.Equal(t, [][]string{
+		{"libncurses6", "terminfo-base"},
+		{"libdw1", "libebl-plugins", "libelf1"},
+		{"rpm-config-SUSE", "rpm-ndb"},
+	}, cycles)
+
+	// why is perl installed?
+	var perl, rpm *PackageInfo
+	for _, pkg := range g.Packages {
+		switch pkg.Name {
+		case "perl-base":
+			perl = pkg
+		case "rpm-ndb":
+			rpm = pkg
+		}
+	}
+	require.NotNil(t, perl)
+	assert.Equal(t, []string{"rpm-ndb"}, names(g.Dependents(perl)))
+	assert.Equal(t, []string{"rpm-config-SUSE", "rpm-ndb"}, names(g.TransitiveDependents(perl)))
+	assert.Equal(t, []string{"system-user-root", "filesystem", "glibc", "libcrypt1"}, names(g.TransitiveDependencies(perl)))
+	assert.Equal(t, []Dependency{{Name: "/usr/bin/perl", Flags: RPMSENSE_FIND_REQUIRES}}, g.Requirements(rpm, perl))
+	assert.Nil(t, g.Requirements(perl, rpm))
+
+	// the caller owns Packages
+	g.Packages[0] = nil
+	g2, err := db.Graph()
+	require.NoError(t, err)
+	assert.NotNil(t, g2.Packages[0])
+}
+
+func TestGraph_Encode(t *testing.T) {
+	pkg := func(name string, requires ...string) *PackageInfo {
+		return &PackageInfo{
+			Name:     name,
+			Version:  "1.0",
+			Release:  "1",
+			Arch:     "noarch",
+			Provides: []string{name},
+			Requires: requires,
+		}
+	}
+	a := pkg("a", "b", "(c or e)")
+	b := pkg("b", "a")
+	c := pkg("c")
+	d := pkg("d")
+
+	idx, err := newPackageIndex([]*PackageInfo{a, b, c, d})
+	require.NoError(t, err)
+	g, err := newGraph(idx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*PackageInfo{b, c}, g.Dependencies(a))
+	assert.Equal(t, [][]*PackageInfo{{a, b}}, g.Cycles())
+	assert.Equal(t, []*PackageInfo{d}, g.Orphans())
+
+	var buf bytes.Buffer
+	require.NoError(t, g.WriteDOT(&buf))
+	assert.Equal(t, `digraph rpmdb {
+	"a-1.0-1.noarch";
+	"a-1.0-1.noarch" -> "b-1.0-1.noarch";
+	"a-1.0-1.noarch" -> "c-1.0-1.noarch";
+	"b-1.0-1.noarch";
+	"b-1.0-1.noarch" -> "a-1.0-1.noarch";
+	"c-1.0-1.noarch";
+	"d-1.0-1.noarch";
+}
+`, buf.String())
+
+	got, err := json.Marshal(g)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"packages": ["a-1.0-1.noarch", "b-1.0-1.noarch", "c-1.0-1.noarch", "d-1.0-1.noarch"],
+		"edges": [
+			{"from": "a-1.0-1.noarch", "to": "b-1.0-1.noarch", "requires": ["b"]},
+			{"from": "a-1.0-1.noarch", "to": "c-1.0-1.noarch", "requires": ["(c or e)"]},
+			{"from": "b-1.0-1.noarch", "to": "a-1.0-1.noarch", "requires": ["a"]}
+		]
+	}`, string(got))
+}
+
+func TestGraph_richDependencies(t *testing.T) {
+	pkg := func(name string, requires ...string) *PackageInfo {
+		return &PackageInfo{Name: name, Provides: []string{name}, Requires: requires}
+	}
+	a := pkg("a",
+		"(b if c)",              // c is only a condition
+		"(d if missing else e)", // the else branch is required
+		"(f or g or missing)",   // every installed alternative is linked
+		"(h unless i)",          // i is installed, so h is not required
+		"(j without k)",         // k is a negative condition
+		"(l with m)",
+	)
+	installed := []*PackageInfo{a}
+	for _, name := range []string{"b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m"} {
+		installed = append(installed, pkg(name))
+	}
+
+	idx, err := newPackageIndex(installed)
+	require.NoError(t, err)
+	g, err := newGraph(idx)
+	require.NoError(t, err)
+
+	var got []string
+	for _, dep := range g.Dependencies(a) {
+		got = append(got, dep.Name)
+	}
+	assert.Equal(t, []string{"b", "e", "f", "g", "j", "l", "m"}, got)
+	assert.Empty(t, g.Dependents(installed[2]), "c")
+}
@@ -0,0 +1,222 @@
+package rpmdb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+var ErrorTagNotFound = xerrors.New("tag not found")
+
+// Header is a package header as stored in the rpmdb. Unlike PackageInfo, it gives
+// access to every tag of the package.
+type Header struct {
+	blob     []byte
+	entries  []indexEntry
+	index    map[int32]int
+	instance uint32
+}
+
+func parseHeader(blob []byte) (*Header, error) {
+	indexEntries, err := headerImport(blob)
+	if err != nil {
+		return nil, xerrors.Errorf("error during importing header: %w", err)
+	}
+	h := newHeader(indexEntries)
+	h.blob = blob
+	return h, nil
+}
+
+func newHeader(indexEntries []indexEntry) *Header {
+	h := &Header{
+		entries: indexEntries,
+		index:   make(map[int32]int, len(indexEntries)),
+	}
+	for i, ie := range indexEntries {
+		h.index[ie.Info.Tag] = i
+	}
+	return h
+}
+
+// PackageInfo decodes the fields of PackageInfo from the header.
+func (h *Header) PackageInfo() (*PackageInfo, error) {
+	pkg, err := getNEVRA(h.entries)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid package info: %w", err)
+	}
+	pkg.DBInstance = h.instance
+	return pkg, nil
+}
+
+// DBInstance returns the number the header is stored under in the database, like
+// rpm -q --qf '%{DBINSTANCE}'. It is 0 for a header not read from a database.
+func (h *Header) DBInstance() uint32 {
+	return h.instance
+}
+
+// Tags returns the tags present in the header in ascending order.
+func (h *Header) Tags() []int32 {
+	tags := make([]int32, 0, len(h.index))
+	for tag := range h.index {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+	return tags
+}
+
+// Has reports whether the header carries tag.
+func (h *Header) Has(tag int32) bool {
+	_, ok := h.index[tag]
+	return ok
+}
+
+// Type returns the data type (RPM_*_TYPE) tag is stored with.
+func (h *Header) Type(tag int32) (uint32, bool) {
+	ie, ok := h.entry(tag)
+	if !ok {
+		return 0, false
+	}
+	return ie.Info.Type, true
+}
+
+// Count returns the number of elements stored for tag.
+func (h *Header) Count(tag int32) (int, bool) {
+	ie, ok := h.entry(tag)
+	if !ok {
+		return 0, false
+	}
+	return int(ie.Info.Count), true
+}
+
+func (h *Header) entry(tag int32) (indexEntry, bool) {
+	i, ok := h.index[tag]
+	if !ok {
+		return indexEntry{}, false
+	}
+	return h.entries[i], true
+}
+
+func (h *Header) lookup(tag int32, types ...uint32) (indexEntry, error) {
+	ie, ok := h.entry(tag)
+	if !ok {
+		return indexEntry{}, xerrors.Errorf("tag %s: %w", TagName(tag), ErrorTagNotFound)
+	}
+	for _, t := range types {
+		if ie.Info.Type == t {
+			return ie, nil
+		}
+	}
+	return indexEntry{}, invalidTypeError(ie)
+}
+
+// GetString returns the value of a string tag. For an international string, the
+// untranslated ("C" locale) value is returned.
+func (h *Header) GetString(tag int32) (string, error) {
+	ie, err := h.lookup(tag, RPM_STRING_TYPE, RPM_I18NSTRING_TYPE)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.Split(ie.Data, []byte{0})[0]), nil
+}
+
+// GetStringArray returns the values of a string array tag.
+func (h *Header) GetStringArray(tag int32) ([]string, error) {
+	ie, err := h.lookup(tag, RPM_STRING_ARRAY_TYPE)
+	if err != nil {
+		return nil, err
+	}
+	return parseStringArray(ie.Data), nil
+}
+
+// GetI18NString returns the translation of an international string tag for lang,
+// e.g. "de_DE.UTF-8". Like rpm, it falls back to less specific locales ("de_DE",
+// "de") and finally to the untranslated value.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.3-release/lib/header.c
+func (h *Header) GetI18NString(tag int32, lang string) (string, error) {
+	ie, err := h.lookup(tag, RPM_I18NSTRING_TYPE, RPM_STRING_TYPE)
+	if err != nil {
+		return "", err
+	}
+	values := strings.Split(string(ie.Data), "\x00")
+	if ie.Info.Type == RPM_STRING_TYPE || lang == "" || lang == "C" {
+		return values[0], nil
+	}
+
+	table, err := h.GetStringArray(HEADER_I18NTABLE)
+	if err != nil {
+		return values[0], nil
+	}
+	for _, l := range localeFallbacks(lang) {
+		for i, locale := range table {
+			if locale == l && i < int(ie.Info.Count) && i < len(values) {
+				return values[i], nil
+			}
+		}
+	}
+	return values[0], nil
+}
+
+// localeFallbacks expands "de_DE.UTF-8@euro" to the locales to try, from the most
+// to the least specific.
+func localeFallbacks(lang string) []string {
+	locales := []string{lang}
+	for _, sep := range []string{"@", ".", "_"} {
+		if i := strings.Index(lang, sep); i > 0 {
+			lang = lang[:i]
+			locales = append(locales, lang)
+		}
+	}
+	return locales
+}
+
+// GetInt32Array returns the values of an int32 tag. Scalar tags are returned as
+// an array of one element.
+func (h *Header) GetInt32Array(tag int32) ([]int32, error) {
+	ie, err := h.lookup(tag, RPM_INT32_TYPE)
+	if err != nil {
+		return nil, err
+	}
+	return parseInt32Array(ie.Data, ie.Length)
+}
+
+// GetInt64Array returns the values of an int64 tag.
+func (h *Header) GetInt64Array(tag int32) ([]int64, error) {
+	ie, err := h.lookup(tag, RPM_INT64_TYPE)
+	if err != nil {
+		return nil, err
+	}
+	return parseInt64Array(ie.Data, ie.Length)
+}
+
+// GetUint16Array returns the values of an int16 tag, such as file modes.
+func (h *Header) GetUint16Array(tag int32) ([]uint16, error) {
+	ie, err := h.lookup(tag, RPM_INT16_TYPE)
+	if err != nil {
+		return nil, err
+	}
+	return uint16Array(ie.Data, ie.Length)
+}
+
+// GetBinary returns the raw value of a binary, char or int8 tag.
+func (h *Header) GetBinary(tag int32) ([]byte, error) {
+	ie, err := h.lookup(tag, RPM_BIN_TYPE, RPM_CHAR_TYPE, RPM_INT8_TYPE)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Clone(ie.Data), nil
+}
+
+const sizeOfInt64 = 8
+
+func parseInt64Array(data []byte, arraySize int) ([]int64, error) {
+	length := arraySize / sizeOfInt64
+	values := make([]int64, length)
+	reader := bytes.NewReader(data)
+	if err := binary.Read(reader, binary.BigEndian, &values); err != nil {
+		return nil, xerrors.Errorf("failed to read binary: %w", err)
+	}
+	return values, nil
+}
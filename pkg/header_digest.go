@@ -0,0 +1,81 @@
+package rpmdb
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+
+	"golang.org/x/xerrors"
+)
+
+var ErrorHeaderDigestMismatch = xerrors.New("header digest mismatch")
+
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.3-release/lib/header.c#L116-L118
+var rpmHeaderMagic = []byte{0x8e, 0xad, 0xe8, 0x01, 0x00, 0x00, 0x00, 0x00}
+
+// immutableRegion returns the bytes the header digests and signatures are
+// computed over: the immutable region exported as a header on its own, i.e. the
+// header as it was in the package file. It returns nil for a header without a
+// region, as written by very old rpm versions.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.3-release/lib/signature.c#L375-L440
+func (h *Header) immutableRegion() ([]byte, error) {
+	blob, err := hdrblobInit(h.blob)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to initialize header blob: %w", err)
+	}
+	if blob.regionTag != RPMTAG_HEADERIMMUTABLE {
+		return nil, nil
+	}
+
+	// the region is made of the first ril entries and the first rdl bytes of data
+	entriesStart := int32(8)
+	entriesEnd := entriesStart + blob.ril*REGION_TAG_COUNT
+	if entriesEnd > blob.dataStart || blob.dataStart+blob.rdl > int32(len(h.blob)) {
+		return nil, xerrors.New("invalid region size")
+	}
+
+	region := append([]byte{}, rpmHeaderMagic...)
+	region = binary.BigEndian.AppendUint32(region, uint32(blob.ril))
+	region = binary.BigEndian.AppendUint32(region, uint32(blob.rdl))
+	region = append(region, h.blob[entriesStart:entriesEnd]...)
+	region = append(region, h.blob[blob.dataStart:blob.dataStart+blob.rdl]...)
+	return region, nil
+}
+
+// verifyDigests recomputes the SHA1 and SHA256 digests of the immutable region and
+// compares them with RPMTAG_SHA1HEADER and RPMTAG_SHA256HEADER. RPMTAG_SIGMD5
+// also covers the payload, which is not in the rpmdb, so it cannot be checked. A
+// header without a region or without digests is not an error.
+func (h *Header) verifyDigests() error {
+	region, err := h.immutableRegion()
+	if err != nil || region == nil {
+		return err
+	}
+
+	digests := []struct {
+		tag     int32
+		name    string
+		newHash func() hash.Hash
+	}{
+		{RPMTAG_SHA1HEADER, "SHA1", sha1.New},
+		{RPMTAG_SHA256HEADER, "SHA256", sha256.New},
+	}
+	for _, digest := range digests {
+		if !h.Has(digest.tag) {
+			continue
+		}
+		want, err := h.GetString(digest.tag)
+		if err != nil {
+			return xerrors.Errorf("invalid %s header digest: %w", digest.name, err)
+		}
+
+		hash := digest.newHash()
+		hash.Write(region)
+		if got := hex.EncodeToString(hash.Sum(nil)); got != want {
+			return xerrors.Errorf("%s digest %s, expected %s: %w", digest.name, got, want, ErrorHeaderDigestMismatch)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package rpmdb
+
+import (
+	"bytes"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+)
+
+func TestRpmDB_VerifyHeaderDigests(t *testing.T) {
+	tests := []struct {
+		name string
+		file string // Test input file
+	}{
+		{
+			name: "BerkeleyDB",
+			file: "testdata/libuuid/Packages",
+		},
+		{
+			name: "NDB",
+			file: "testdata/sle15-bci/Packages.db",
+		},
+		{
+			name: "SQLite3",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			db.VerifyHeaderDigests(true)
+			pkgs, err := db.ListPackages()
+			require.NoError(t, err)
+			assert.NotEmpty(t, pkgs)
+		})
+	}
+}
+
+func TestRpmDB_VerifyHeaderDigests_tampered(t *testing.T) {
+	file := copyFile(t, "testdata/cbl-mariner-2.0/rpmdb.sqlite", filepath.Join(t.TempDir(), "rpmdb.sqlite"))
+
+	// change the summary of bash, which is in the immutable region
+	sqldb, err := sql.Open("sqlite", file)
+	require.NoError(t, err)
+	var blob []byte
+	require.NoError(t, sqldb.QueryRow("SELECT blob FROM Packages WHERE hnum = 29").Scan(&blob))
+	require.True(t, bytes.Contains(blob, []byte("Bourne")))
+	blob = bytes.Replace(blob, []byte("Bourne"), []byte("Bourny"), 1)
+	_, err = sqldb.Exec("UPDATE Packages SET blob = ? WHERE hnum = 29", blob)
+	require.NoError(t, err)
+	require.NoError(t, sqldb.Close())
+
+	db, err := Open(file)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// not verified by default
+	pkg, err := db.Package("bash")
+	require.NoError(t, err)
+	assert.Equal(t, "bash", pkg.Name)
+
+	db.VerifyHeaderDigests(true)
+
+	var errs []error
+	var count int
+	for pkg, err := range db.All() {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		assert.NotEqual(t, "bash", pkg.Name)
+		count++
+	}
+	require.Len(t, errs, 1)
+	assert.True(t, xerrors.Is(errs[0], ErrorHeaderDigestMismatch))
+	assert.ErrorContains(t, errs[0], "bash (header 29)")
+	assert.Equal(t, 128, count)
+
+	_, err = db.PackageByID(29)
+	assert.True(t, xerrors.Is(err, ErrorHeaderDigestMismatch))
+}
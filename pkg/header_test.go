@@ -0,0 +1,112 @@
+package rpmdb
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func libuuidHeader(t *testing.T) *Header {
+	t.Helper()
+
+	db, err := Open("testdata/libuuid/Packages")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	for h, err := range db.Headers() {
+		require.NoError(t, err)
+		if name, _ := h.GetString(RPMTAG_NAME); name == "libuuid" {
+			return h
+		}
+	}
+	t.Fatal("libuuid not found")
+	return nil
+}
+
+func TestHeader(t *testing.T) {
+	h := libuuidHeader(t)
+
+	t.Run("string", func(t *testing.T) {
+		got, err := h.GetString(RPMTAG_VERSION)
+		require.NoError(t, err)
+		assert.Equal(t, "2.32.1", got)
+	})
+
+	t.Run("i18n string", func(t *testing.T) {
+		got, err := h.GetString(RPMTAG_SUMMARY)
+		require.NoError(t, err)
+		assert.Equal(t, "Universally unique ID library", got)
+
+		got, err = h.GetI18NString(RPMTAG_SUMMARY, "de_DE.UTF-8")
+		require.NoError(t, err)
+		assert.Equal(t, "Universally unique ID library", got)
+	})
+
+	t.Run("string array", func(t *testing.T) {
+		got, err := h.GetStringArray(RPMTAG_PROVIDENAME)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"libuuid",
+			"libuuid(x86-64)",
+			"libuuid.so.1()(64bit)",
+			"libuuid.so.1(UUIDD_PRIVATE)(64bit)",
+			"libuuid.so.1(UUID_1.0)(64bit)",
+			"libuuid.so.1(UUID_2.20)(64bit)",
+			"libuuid.so.1(UUID_2.31)(64bit)",
+		}, got)
+	})
+
+	t.Run("int32", func(t *testing.T) {
+		got, err := h.GetInt32Array(RPMTAG_SIZE)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{35104}, got)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		got, err := h.GetBinary(RPMTAG_SIGMD5)
+		require.NoError(t, err)
+		assert.Equal(t, "c1e561f13d39aee443a1f00258fba000", hex.EncodeToString(got))
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		tags := h.Tags()
+		assert.IsIncreasing(t, tags)
+		assert.Contains(t, tags, int32(RPMTAG_NAME))
+		assert.True(t, h.Has(RPMTAG_NAME))
+		assert.False(t, h.Has(RPMTAG_EPOCH))
+
+		typ, ok := h.Type(RPMTAG_REQUIRENAME)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(RPM_STRING_ARRAY_TYPE), typ)
+
+		count, ok := h.Count(RPMTAG_REQUIRENAME)
+		assert.True(t, ok)
+		assert.Equal(t, 17, count)
+	})
+
+	t.Run("package info", func(t *testing.T) {
+		got, err := h.PackageInfo()
+		require.NoError(t, err)
+		assert.Equal(t, "libuuid", got.Name)
+		assert.Equal(t, "42.el8_8", got.Release)
+	})
+
+	t.Run("missing tag", func(t *testing.T) {
+		_, err := h.GetString(RPMTAG_EPOCH)
+		assert.ErrorIs(t, err, ErrorTagNotFound)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		_, err := h.GetStringArray(RPMTAG_NAME)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrorTagNotFound)
+		assert.Contains(t, err.Error(), "invalid type STRING for tag NAME")
+	})
+}
+
+func Test_localeFallbacks(t *testing.T) {
+	assert.Equal(t, []string{"de_DE.UTF-8@euro", "de_DE.UTF-8", "de_DE", "de"}, localeFallbacks("de_DE.UTF-8@euro"))
+	assert.Equal(t, []string{"de"}, localeFallbacks("de"))
+}
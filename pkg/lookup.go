@@ -0,0 +1,105 @@
+package rpmdb
+
+import (
+	"context"
+	"path"
+	"slices"
+
+	dbi "github.com/knqyf263/go-rpmdb/pkg/db"
+	"golang.org/x/xerrors"
+)
+
+var errStaleIndex = xerrors.New("stale index")
+
+// PackagesByFile returns the packages containing the file p, looking it up in the
+// Basenames index of the database.
+func (d *RpmDB) PackagesByFile(p string) ([]*PackageInfo, error) {
+	return d.PackagesByFileContext(context.Background(), p)
+}
+
+// PackagesByFileContext is like PackagesByFile, but gives up once ctx is done.
+func (d *RpmDB) PackagesByFileContext(ctx context.Context, p string) ([]*PackageInfo, error) {
+	p = path.Clean(p)
+	base := path.Base(p)
+	hasBaseName := func(pkg *PackageInfo) bool { return slices.Contains(pkg.BaseNames, base) }
+	return d.lookup(ctx, dbi.IndexBasenames, base, hasBaseName, func(pkg *PackageInfo) bool {
+		fileNames, err := pkg.InstalledFileNames()
+		return err == nil && slices.Contains(fileNames, p)
+	})
+}
+
+// PackagesByProvide returns the packages providing the capability name, looking
+// it up in the Providename index of the database. Versions are not compared; see
+// WhatProvides for that.
+func (d *RpmDB) PackagesByProvide(name string) ([]*PackageInfo, error) {
+	return d.PackagesByProvideContext(context.Background(), name)
+}
+
+// PackagesByProvideContext is like PackagesByProvide, but gives up once ctx is done.
+func (d *RpmDB) PackagesByProvideContext(ctx context.Context, name string) ([]*PackageInfo, error) {
+	provides := func(pkg *PackageInfo) bool { return slices.Contains(pkg.Provides, name) }
+	return d.lookup(ctx, dbi.IndexProvidename, name, provides, provides)
+}
+
+// lookup returns the packages listed under key in one of the indexes rpm keeps
+// next to the headers, so that only those headers are read, and filters them with
+// match. hasKey tells whether a package really has key in the indexed tag. Every
+// header is read instead if the backend cannot read the index, or if the index
+// turns out to be stale, i.e. it lists a header that is gone or lacks the key.
+func (d *RpmDB) lookup(ctx context.Context, index dbi.Index, key string, hasKey, match func(*PackageInfo) bool) ([]*PackageInfo, error) {
+	pkgs, err := d.indexLookup(ctx, index, key, hasKey)
+	if err == nil {
+		return slices.DeleteFunc(pkgs, func(pkg *PackageInfo) bool { return !match(pkg) }), nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+
+	// fall back to a full scan
+	pkgs = nil
+	for pkg, err := range d.AllContext(ctx) {
+		if err != nil {
+			return nil, xerrors.Errorf("unable to list packages: %w", err)
+		}
+		if match(pkg) {
+			pkgs = append(pkgs, pkg)
+		}
+	}
+	return pkgs, nil
+}
+
+func (d *RpmDB) indexLookup(ctx context.Context, index dbi.Index, key string, hasKey func(*PackageInfo) bool) ([]*PackageInfo, error) {
+	indexer, ok := d.db.(dbi.Indexer)
+	if !ok {
+		return nil, dbi.ErrorIndexNotFound
+	}
+
+	hnums, err := indexer.LookupContext(ctx, index, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var pkgs []*PackageInfo
+	for _, hnum := range hnums {
+		blob, err := indexer.GetContext(ctx, hnum)
+		if xerrors.Is(err, dbi.ErrorHeaderNotFound) {
+			return nil, xerrors.Errorf("%s: %w", err, errStaleIndex)
+		} else if err != nil {
+			return nil, err
+		}
+
+		h, err := d.readHeader(hnum, blob)
+		if err != nil {
+			return nil, err
+		}
+		pkg, err := h.PackageInfo()
+		if err != nil {
+			return nil, err
+		}
+		if !hasKey(pkg) {
+			return nil, xerrors.Errorf("%s %q lists header %d: %w", index, key, hnum, errStaleIndex)
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs, nil
+}
@@ -0,0 +1,483 @@
+package rpmdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/knqyf263/go-rpmdb/pkg/bdb"
+	dbi "github.com/knqyf263/go-rpmdb/pkg/db"
+	"github.com/knqyf263/go-rpmdb/pkg/ndb"
+)
+
+func TestRpmDB_PackagesByFile(t *testing.T) {
+	tests := []struct {
+		name string
+		file string // Test input file
+		path string
+		want []string
+	}{
+		{
+			name: "BerkeleyDB without index files",
+			file: "testdata/libuuid/Packages",
+			path: "/usr/lib64/libuuid.so.1",
+			want: []string{"libuuid"},
+		},
+		{
+			name: "NDB",
+			file: "testdata/sle15-bci/Packages.db",
+			path: "/usr/bin/bash",
+			want: []string{"bash"},
+		},
+		{
+			name: "SQLite",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			path: "/bin/bash",
+			want: []string{"bash"},
+		},
+		{
+			name: "SQLite with the same basename in another directory",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			path: "/usr/bin/bash",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkgs, err := db.PackagesByFile(tt.path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, packageNames(pkgs))
+		})
+	}
+}
+
+func TestRpmDB_PackagesByProvide(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string // Test input file
+		provide string
+		want    []string
+	}{
+		{
+			name:    "BerkeleyDB without index files",
+			file:    "testdata/libuuid/Packages",
+			provide: "libuuid.so.1()(64bit)",
+			want:    []string{"libuuid"},
+		},
+		{
+			name:    "NDB",
+			file:    "testdata/sle15-bci/Packages.db",
+			provide: "libc.so.6()(64bit)",
+			want:    []string{"glibc"},
+		},
+		{
+			name:    "SQLite",
+			file:    "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			provide: "libc.so.6()(64bit)",
+			want:    []string{"glibc"},
+		},
+		{
+			name:    "SQLite not provided",
+			file:    "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			provide: "libfoo.so.1()(64bit)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkgs, err := db.PackagesByProvide(tt.provide)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, packageNames(pkgs))
+		})
+	}
+}
+
+func TestRpmDB_lookup_staleSQLiteIndex(t *testing.T) {
+	file := copyFile(t, "testdata/cbl-mariner-2.0/rpmdb.sqlite", filepath.Join(t.TempDir(), "rpmdb.sqlite"))
+
+	// point bash at another package, then at a header that does not exist
+	sqldb, err := sql.Open("sqlite", file)
+	require.NoError(t, err)
+	_, err = sqldb.Exec("UPDATE Name SET hnum = 1 WHERE key = 'bash'")
+	require.NoError(t, err)
+	_, err = sqldb.Exec("UPDATE Providename SET hnum = 9999 WHERE key = 'libc.so.6()(64bit)'")
+	require.NoError(t, err)
+	require.NoError(t, sqldb.Close())
+
+	db, err := Open(file)
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkg, err := db.Package("bash")
+	require.NoError(t, err)
+	assert.Equal(t, "bash", pkg.Name)
+
+	pkgs, err := db.PackagesByProvide("libc.so.6()(64bit)")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"glibc"}, packageNames(pkgs))
+}
+
+func TestRpmDB_lookup_berkeleyDBIndex(t *testing.T) {
+	dir := t.TempDir()
+	copyFile(t, "testdata/libuuid/Packages", filepath.Join(dir, "Packages"))
+
+	// libuuid is header number 1
+	writeBtreeIndex(t, filepath.Join(dir, "Name"), map[string][]bdb.IndexItem{
+		"libuuid": {{HeaderNum: 1, TagNum: 0}},
+		"bash":    {{HeaderNum: 1, TagNum: 0}}, // stale
+	})
+
+	idx, err := bdb.OpenIndex(filepath.Join(dir, "Name"))
+	require.NoError(t, err)
+	items, err := idx.LookupContext(context.Background(), []byte("libuuid"))
+	require.NoError(t, err)
+	assert.Equal(t, []bdb.IndexItem{{HeaderNum: 1, TagNum: 0}}, items)
+	items, err = idx.LookupContext(context.Background(), []byte("libuui"))
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	require.NoError(t, idx.Close())
+
+	db, err := Open(filepath.Join(dir, "Packages"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkg, err := db.Package("libuuid")
+	require.NoError(t, err)
+	assert.Equal(t, "libuuid", pkg.Name)
+
+	_, err = db.Package("bash")
+	require.ErrorContains(t, err, "bash is not installed")
+}
+
+func TestRpmDB_lookup_ndbIndex(t *testing.T) {
+	idx, err := ndb.OpenIndex("testdata/sle15-bci/Index.db")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(46), idx.UserGeneration)
+
+	// bash is PkgIndex 14, /usr/bin/bash is its first file
+	items, err := idx.LookupContext(context.Background(), 1000, []byte("bash"))
+	require.NoError(t, err)
+	assert.Equal(t, []ndb.IndexItem{{HeaderNum: 14, TagNum: 0}}, items)
+	items, err = idx.LookupContext(context.Background(), 1117, []byte("bash"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []ndb.IndexItem{
+		{HeaderNum: 14, TagNum: 0},
+		{HeaderNum: 14, TagNum: 6},
+		{HeaderNum: 14, TagNum: 10},
+		{HeaderNum: 14, TagNum: 88},
+	}, items)
+	items, err = idx.LookupContext(context.Background(), 1047, []byte("libc.so.6()(64bit)"))
+	require.NoError(t, err)
+	assert.Equal(t, []ndb.IndexItem{{HeaderNum: 3, TagNum: 12}}, items)
+	items, err = idx.LookupContext(context.Background(), 1000, []byte("bas"))
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	_, err = idx.LookupContext(context.Background(), 1118, []byte("/usr/bin/"))
+	require.ErrorIs(t, err, dbi.ErrorIndexNotFound)
+	require.NoError(t, idx.Close())
+
+	dir := t.TempDir()
+	copyFile(t, "testdata/sle15-bci/Packages.db", filepath.Join(dir, "Packages.db"))
+	index := copyFile(t, "testdata/sle15-bci/Index.db", filepath.Join(dir, "Index.db"))
+
+	ndbDB, err := ndb.Open(filepath.Join(dir, "Packages.db"))
+	require.NoError(t, err)
+	defer ndbDB.Close()
+
+	hnums, err := ndbDB.LookupContext(context.Background(), dbi.IndexBasenames, "bash")
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{14}, hnums)
+
+	// an index of another generation of Packages.db is outdated
+	b, err := os.ReadFile(index)
+	require.NoError(t, err)
+	binary.LittleEndian.PutUint32(b[20:], 45)
+	require.NoError(t, os.WriteFile(index, b, 0o644))
+
+	_, err = ndbDB.LookupContext(context.Background(), dbi.IndexBasenames, "bash")
+	require.ErrorIs(t, err, dbi.ErrorIndexNotFound)
+
+	db, err := Open(filepath.Join(dir, "Packages.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkg, err := db.Package("bash")
+	require.NoError(t, err)
+	assert.Equal(t, "bash", pkg.Name)
+}
+
+func TestRpmDB_Packages(t *testing.T) {
+	file := copyFile(t, "testdata/cbl-mariner-2.0/rpmdb.sqlite", filepath.Join(t.TempDir(), "rpmdb.sqlite"))
+
+	// install glibc a second time
+	sqldb, err := sql.Open("sqlite", file)
+	require.NoError(t, err)
+	res, err := sqldb.Exec("INSERT INTO Packages (blob) SELECT blob FROM Packages WHERE hnum = 3")
+	require.NoError(t, err)
+	hnum, err := res.LastInsertId()
+	require.NoError(t, err)
+	_, err = sqldb.Exec("INSERT INTO Name (key, hnum, idx) VALUES ('glibc', ?, 0)", hnum)
+	require.NoError(t, err)
+	require.NoError(t, sqldb.Close())
+
+	db, err := Open(file)
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkgs, err := db.Packages("glibc")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"glibc", "glibc"}, packageNames(pkgs))
+
+	pkg, err := db.Package("glibc")
+	require.NoError(t, err)
+	assert.Equal(t, pkgs[0], pkg)
+
+	pkgs, err = db.PackagesByNameArch("glibc", "x86_64")
+	require.NoError(t, err)
+	assert.Len(t, pkgs, 2)
+
+	_, err = db.PackagesByNameArch("glibc", "i686")
+	require.ErrorContains(t, err, "glibc.i686 is not installed")
+
+	_, err = db.Packages("zsh")
+	require.ErrorContains(t, err, "zsh is not installed")
+}
+
+func TestRpmDB_PackageByNEVRA(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string // Test input file
+		nevra   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "SQLite",
+			file:  "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			nevra: "glibc-2.34-2.cm2.x86_64",
+			want:  "glibc-2.34-2.cm2.x86_64",
+		},
+		{
+			name:  "NDB with epoch 0",
+			file:  "testdata/sle15-bci/Packages.db",
+			nevra: "bash-0:4.4-19.6.1.x86_64",
+			want:  "bash-4.4-19.6.1.x86_64",
+		},
+		{
+			name:    "other epoch",
+			file:    "testdata/sle15-bci/Packages.db",
+			nevra:   "1:bash-4.4-19.6.1.x86_64",
+			wantErr: "1:bash-4.4-19.6.1.x86_64 is not installed",
+		},
+		{
+			name:    "other arch",
+			file:    "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			nevra:   "glibc-2.34-2.cm2.i686",
+			wantErr: "glibc-2.34-2.cm2.i686 is not installed",
+		},
+		{
+			name:    "invalid NEVRA",
+			file:    "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			nevra:   "glibc",
+			wantErr: "invalid NEVRA",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkg, err := db.PackageByNEVRA(tt.nevra)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, pkg.NEVRA().String())
+		})
+	}
+}
+
+func TestRpmDB_PackageByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string // Test input file
+		id      uint32
+		want    string
+		wantErr string
+	}{
+		{
+			name: "BerkeleyDB",
+			file: "testdata/libuuid/Packages",
+			id:   1,
+			want: "libuuid",
+		},
+		{
+			name: "NDB",
+			file: "testdata/sle15-bci/Packages.db",
+			id:   14,
+			want: "bash",
+		},
+		{
+			name: "SQLite",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			id:   29,
+			want: "bash",
+		},
+		{
+			name:    "BerkeleyDB not found",
+			file:    "testdata/libuuid/Packages",
+			id:      2,
+			wantErr: "header not found",
+		},
+		{
+			name:    "NDB not found",
+			file:    "testdata/sle15-bci/Packages.db",
+			id:      9999,
+			wantErr: "header not found",
+		},
+		{
+			name:    "SQLite not found",
+			file:    "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			id:      9999,
+			wantErr: "header not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkg, err := db.PackageByID(tt.id)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, pkg.Name)
+			assert.Equal(t, tt.id, pkg.DBInstance)
+
+			// the same identifier as when listing the packages
+			pkgs, err := db.Packages(tt.want)
+			require.NoError(t, err)
+			assert.Equal(t, pkgs[0], pkg)
+		})
+	}
+}
+
+func TestBerkeleyDB_GetContext(t *testing.T) {
+	f, err := os.Open("testdata/libuuid/Packages")
+	require.NoError(t, err)
+	defer f.Close()
+
+	r := &countingReaderAt{r: f}
+	db, err := bdb.OpenReaderAt(r)
+	require.NoError(t, err)
+
+	var entries []dbi.Entry
+	for entry := range db.Read() {
+		require.NoError(t, entry.Err)
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 1)
+
+	blob, err := db.GetContext(context.Background(), entries[0].HeaderNum)
+	require.NoError(t, err)
+	assert.Equal(t, entries[0].Value, blob)
+
+	// only the page of the bucket is read, not every page of the database
+	r.reads = 0
+	_, err = db.GetContext(context.Background(), 9999)
+	require.ErrorIs(t, err, dbi.ErrorHeaderNotFound)
+	assert.Equal(t, 1, r.reads)
+}
+
+type countingReaderAt struct {
+	r     io.ReaderAt
+	reads int
+}
+
+func (c *countingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	c.reads++
+	return c.r.ReadAt(p, off)
+}
+
+func packageNames(pkgs []*PackageInfo) []string {
+	var names []string
+	for _, pkg := range pkgs {
+		names = append(names, pkg.Name)
+	}
+	return names
+}
+
+func copyFile(t *testing.T, src, dst string) string {
+	t.Helper()
+	b, err := os.ReadFile(src)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(dst, b, 0o644))
+	return dst
+}
+
+// writeBtreeIndex writes a little-endian Berkeley DB btree with a metadata page
+// and a single leaf page, the way rpm stores its secondary indexes.
+func writeBtreeIndex(t *testing.T, name string, entries map[string][]bdb.IndexItem) {
+	t.Helper()
+	const pageSize = 512
+	le := binary.LittleEndian
+
+	meta := make([]byte, pageSize)
+	le.PutUint32(meta[12:], bdb.BtreeMagicNumber)
+	le.PutUint32(meta[16:], 9) // version
+	le.PutUint32(meta[20:], pageSize)
+	meta[25] = bdb.BtreeMetadataPageType
+	le.PutUint32(meta[32:], 1) // last page
+	le.PutUint32(meta[88:], 1) // root page
+
+	leaf := make([]byte, pageSize)
+	le.PutUint32(leaf[8:], 1)
+	leaf[24] = 1 // tree level
+	leaf[25] = bdb.BtreeLeafPageType
+
+	var offsets []uint16
+	end := pageSize
+	put := func(data []byte) {
+		// BKEYDATA items are aligned to 4 bytes
+		size := (3 + len(data) + 3) &^ 3
+		end -= size
+		le.PutUint16(leaf[end:], uint16(len(data)))
+		leaf[end+2] = 1 // B_KEYDATA
+		copy(leaf[end+3:], data)
+		offsets = append(offsets, uint16(end))
+	}
+	for key, items := range entries {
+		put([]byte(key))
+		var data []byte
+		for _, item := range items {
+			data = le.AppendUint32(data, item.HeaderNum)
+			data = le.AppendUint32(data, item.TagNum)
+		}
+		put(data)
+	}
+	le.PutUint16(leaf[20:], uint16(len(offsets)))
+	le.PutUint16(leaf[22:], uint16(end))
+	for i, offset := range offsets {
+		le.PutUint16(leaf[bdb.PageHeaderSize+2*i:], offset)
+	}
+
+	require.NoError(t, os.WriteFile(name, append(meta, leaf...), 0o644))
+}
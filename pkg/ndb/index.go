@@ -0,0 +1,309 @@
+package ndb
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+
+	dbi "github.com/knqyf263/go-rpmdb/pkg/db"
+	"golang.org/x/xerrors"
+)
+
+/* rpm keeps the NDB indexes as blobs of a single container file, Index.db:
+
+   https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/ndb/rpmxdb.c
+   https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/ndb/rpmidx.c
+
+   Index.db File Format:
+   =====================
+
+   32 bytes "XDB Header" giving the page size, the number of slot pages and the
+   "user generation", which is the generation of Packages.db the indexes were
+   last synced with. The slots follow the header, 16 bytes each, and locate the
+   blob of every index by its rpm tag.
+
+   Each index blob has a 64 bytes "IDX Header", followed by a hash table of
+   8 bytes slots (key offset, encoded package index and tag number), one 4 bytes
+   overflow word per slot and finally the length-prefixed keys.
+*/
+
+const (
+	XDB_Magic   = 'R' | 'p'<<8 | 'm'<<16 | 'X'<<24
+	XDB_Version = 0
+	IDX_Magic   = 'R' | 'p'<<8 | 'm'<<16 | 'I'<<24
+	IDX_Version = 0
+
+	xdbHeaderSize = 32
+	xdbSlotSize   = 16
+	xdbSlotMagic  = 'S' | 'l'<<8 | 'o'<<16
+	idxHeaderSize = 64
+	murmurM       = 0x5bd1e995
+)
+
+type xdbHeader struct {
+	Magic          uint32
+	Version        uint32
+	Generation     uint32
+	SlotNPages     uint32
+	PageSize       uint32
+	UserGeneration uint32
+	_              [2]uint32
+}
+
+type xdbSlot struct {
+	SubTag    uint32 // SLOT_MAGIC in the low 24 bits
+	BlobTag   uint32
+	StartPage uint32
+	PageCnt   uint32
+}
+
+type idxHeader struct {
+	Magic      uint32
+	Version    uint32
+	Generation uint32
+	NSlots     uint32
+	UsedSlots  uint32
+	DummySlots uint32
+	XMask      uint32
+	KeyEnd     uint32
+	KeyExcess  uint32
+	_          [7]uint32
+}
+
+// Index is the Index.db container next to Packages.db, holding one blob per
+// index, e.g. Name or Basenames.
+type Index struct {
+	r      io.ReaderAt
+	closer io.Closer
+
+	// UserGeneration is the generation of Packages.db the indexes belong to.
+	UserGeneration uint32
+
+	pageSize uint32
+	blobs    map[uint32]xdbSlot // by rpm tag
+}
+
+// IndexItem is an entry of an index: the PkgIndex of a package and the position
+// of the key within the tag, e.g. the file number for Basenames.
+type IndexItem struct {
+	HeaderNum uint32
+	TagNum    uint32
+}
+
+func OpenIndex(path string) (*Index, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, xerrors.Errorf("failed to stat index file: %w", err)
+	}
+
+	idx, err := OpenIndexReaderAt(file, fi.Size())
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	idx.closer = file
+
+	return idx, nil
+}
+
+// OpenIndexReaderAt reads an Index.db container of the given size from r.
+// Closing the returned index does not close r.
+func OpenIndexReaderAt(r io.ReaderAt, size int64) (*Index, error) {
+	sr := io.NewSectionReader(r, 0, size)
+
+	hdr := xdbHeader{}
+	if err := binary.Read(sr, binary.LittleEndian, &hdr); err != nil {
+		return nil, xerrors.Errorf("failed to read index header: %w", err)
+	}
+	if hdr.Magic != XDB_Magic || hdr.Version != XDB_Version ||
+		hdr.SlotNPages == 0 || hdr.PageSize == 0 || hdr.PageSize%xdbSlotSize != 0 {
+		return nil, ErrorInvalidNDB
+	}
+
+	// Sanity check against excessive memory usage
+	if hdr.SlotNPages > 2048 || hdr.PageSize > 65536 {
+		return nil, xerrors.Errorf("slot page limit exceeded: %x", hdr.SlotNPages)
+	}
+
+	// the first two slots are actually the XDB Header
+	slots := make([]xdbSlot, hdr.SlotNPages*(hdr.PageSize/xdbSlotSize)-xdbHeaderSize/xdbSlotSize)
+	if err := binary.Read(sr, binary.LittleEndian, &slots); err != nil {
+		return nil, xerrors.Errorf("failed to read index slot pages: %w", err)
+	}
+
+	blobs := make(map[uint32]xdbSlot)
+	for _, slot := range slots {
+		if slot.SubTag&0x00ffffff != xdbSlotMagic {
+			return nil, xerrors.Errorf("bad slot Magic: %x", slot.SubTag)
+		}
+		// free slot, or one of the blobs rpm uses while rebuilding an index
+		if slot.StartPage == 0 || slot.PageCnt == 0 || slot.SubTag>>24 != 0 {
+			continue
+		}
+		blobs[slot.BlobTag] = slot
+	}
+
+	return &Index{
+		r:              r,
+		UserGeneration: hdr.UserGeneration,
+		pageSize:       hdr.PageSize,
+		blobs:          blobs,
+	}, nil
+}
+
+func (idx *Index) Close() error {
+	if idx.closer == nil {
+		return nil
+	}
+	return idx.closer.Close()
+}
+
+// LookupContext returns the items stored under key in the index of the given
+// rpm tag, e.g. 1000 for Name. It returns dbi.ErrorIndexNotFound if Index.db
+// has no such index.
+// source: rpmidxGetInternal() in rpmidx.c
+func (idx *Index) LookupContext(ctx context.Context, tag uint32, key []byte) ([]IndexItem, error) {
+	slot, ok := idx.blobs[tag]
+	if !ok {
+		return nil, xerrors.Errorf("tag %d: %w", tag, dbi.ErrorIndexNotFound)
+	}
+	blob := io.NewSectionReader(idx.r, int64(slot.StartPage)*int64(idx.pageSize), int64(slot.PageCnt)*int64(idx.pageSize))
+
+	hdr := idxHeader{}
+	if err := binary.Read(blob, binary.LittleEndian, &hdr); err != nil {
+		return nil, xerrors.Errorf("failed to read index blob header: %w", err)
+	}
+	if hdr.Magic != IDX_Magic || hdr.Version != IDX_Version ||
+		hdr.NSlots == 0 || hdr.NSlots&(hdr.NSlots-1) != 0 {
+		return nil, xerrors.Errorf("invalid index blob of tag %d", tag)
+	}
+	keyStart := idxHeaderSize + int64(hdr.NSlots)*12
+	if blob.Size() <= keyStart {
+		return nil, xerrors.Errorf("index blob of tag %d too small for %d slots", tag, hdr.NSlots)
+	}
+
+	// the hash slots, followed by their overflow words
+	slots := make([]byte, int64(hdr.NSlots)*12)
+	if _, err := io.ReadFull(blob, slots); err != nil {
+		return nil, xerrors.Errorf("failed to read index slots: %w", err)
+	}
+	keys := io.NewSectionReader(blob, keyStart, blob.Size()-keyStart)
+
+	keyh := murmurhash(key)
+	hmask := hdr.NSlots - 1
+	var keyoff uint32
+	var items []IndexItem
+	// every slot is probed at most once, a full table has no free slot to stop at
+	h, hh := keyh&hmask, uint32(7)
+	for i := uint32(0); i < hdr.NSlots; i, h, hh = i+1, (h+hh)&hmask, hh+1 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		x := binary.LittleEndian.Uint32(slots[8*h:])
+		if x == 0 {
+			break
+		}
+		if x == 0xffffffff { // deleted
+			continue
+		}
+		if keyoff == 0 {
+			if (x^keyh)&hdr.XMask != 0 {
+				continue
+			}
+			equal, err := equalKey(keys, hdr.KeyEnd, x&^hdr.XMask, key)
+			if err != nil {
+				return nil, err
+			}
+			if !equal {
+				continue
+			}
+			keyoff = x
+		}
+		if keyoff != x {
+			continue
+		}
+
+		data := binary.LittleEndian.Uint32(slots[8*h+4:])
+		items = append(items, decodeData(data, binary.LittleEndian.Uint32(slots[8*hdr.NSlots+4*h:])))
+	}
+	return items, nil
+}
+
+// murmurhash is the hash function of the index keys.
+// source: murmurhash() in rpmidx.c
+func murmurhash(s []byte) uint32 {
+	h := uint32(len(s)) * murmurM
+	for ; len(s) >= 4; s = s[4:] {
+		h += binary.LittleEndian.Uint32(s)
+		h *= murmurM
+		h ^= h >> 16
+	}
+	if len(s) > 0 {
+		switch len(s) {
+		case 3:
+			h += uint32(s[2])<<16 | uint32(s[1])<<8 | uint32(s[0])
+		case 2:
+			h += uint32(s[1])<<8 | uint32(s[0])
+		case 1:
+			h += uint32(s[0])
+		}
+		h *= murmurM
+		h ^= h >> 16
+	}
+	h *= murmurM
+	h ^= h >> 10
+	h *= murmurM
+	h ^= h >> 17
+	return h
+}
+
+// equalKey reports whether the length-prefixed key at off is key.
+// source: equalkey() in rpmidx.c
+func equalKey(keys *io.SectionReader, keyEnd, off uint32, key []byte) (bool, error) {
+	keyl := uint32(len(key))
+	if uint64(off)+uint64(keyl)+1 > uint64(keyEnd) {
+		return false, nil
+	}
+
+	var prefix []byte
+	switch {
+	case keyl != 0 && keyl < 255:
+		prefix = []byte{byte(keyl)}
+	case keyl < 65535:
+		prefix = []byte{255, byte(keyl), byte(keyl >> 8)}
+	default:
+		prefix = []byte{255, 255, 255, byte(keyl), byte(keyl >> 8), byte(keyl >> 16), byte(keyl >> 24)}
+	}
+
+	buf := make([]byte, len(prefix)+len(key))
+	if _, err := keys.ReadAt(buf, int64(off)); err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, xerrors.Errorf("failed to read index key: %w", err)
+	}
+	return bytes.Equal(buf[:len(prefix)], prefix) && bytes.Equal(buf[len(prefix):], key), nil
+}
+
+// decodeData splits the data of a slot into the package index and the tag
+// number, the package index of large entries being kept in the overflow word.
+// source: decodedata() in rpmidx.c
+func decodeData(data, ovldata uint32) IndexItem {
+	switch {
+	case data&0x80000000 != 0:
+		return IndexItem{HeaderNum: ovldata, TagNum: data ^ 0x80000000}
+	case data&0x40000000 != 0:
+		return IndexItem{HeaderNum: data & 0xffffff, TagNum: (data ^ 0x40000000) >> 24}
+	default:
+		return IndexItem{HeaderNum: data & 0xfffff, TagNum: data >> 20}
+	}
+}
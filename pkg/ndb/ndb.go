@@ -22,10 +22,13 @@ SOFTWARE.
 package ndb
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"unsafe"
 
 	dbi "github.com/knqyf263/go-rpmdb/pkg/db"
@@ -80,8 +83,11 @@ type ndbBlobHeader struct {
 }
 
 type RpmNDB struct {
-	file  *os.File
-	slots []ndbSlotEntry
+	r          io.ReaderAt
+	file       *os.File
+	dir        string // where Index.db is, if opened from a path
+	generation uint32
+	slots      []ndbSlotEntry
 }
 
 const NDB_SlotEntriesPerPage = 4096 / 16 /* 16 == unsafe.Sizeof(NDBSlotEntry) */
@@ -98,18 +104,35 @@ func Open(path string) (*RpmNDB, error) {
 
 	err = syscallFlock(int(file.Fd()), syscallLOCK_SH)
 	if err != nil {
+		_ = file.Close()
 		return nil, err
 	}
 
-	hdrBuff := ndbHeader{}
-	err = binary.Read(file, binary.LittleEndian, &hdrBuff)
+	fi, err := file.Stat()
 	if err != nil {
-		return nil, xerrors.Errorf("failed to read metadata: %w", err)
+		_ = file.Close()
+		return nil, xerrors.Errorf("failed to stat NDB file: %w", err)
 	}
 
-	if hdrBuff.HeaderMagic != NDB_HeaderMagic || hdrBuff.SlotNPages == 0 ||
-		hdrBuff.NDBVersion != NDB_DBVersion {
-		return nil, ErrorInvalidNDB
+	db, err := OpenReaderAt(file, fi.Size())
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	db.file = file
+	db.dir = filepath.Dir(path)
+
+	return db, nil
+}
+
+// OpenReaderAt reads an NDB database of the given size from r. Closing the
+// returned database does not close r.
+func OpenReaderAt(r io.ReaderAt, size int64) (*RpmNDB, error) {
+	sr := io.NewSectionReader(r, 0, size)
+
+	hdrBuff, err := readHeader(sr)
+	if err != nil {
+		return nil, err
 	}
 
 	// Sanity check against excessive memory usage
@@ -119,24 +142,66 @@ func Open(path string) (*RpmNDB, error) {
 
 	// the first two slots are actually the NDB Header
 	slots := make([]ndbSlotEntry, hdrBuff.SlotNPages*NDB_SlotEntriesPerPage-2)
-	err = binary.Read(file, binary.LittleEndian, &slots)
+	err = binary.Read(sr, binary.LittleEndian, &slots)
 
 	if err != nil {
 		return nil, xerrors.Errorf("failed to read NDB slot pages: %w", err)
 	}
 
 	return &RpmNDB{
-		file:  file,
-		slots: slots,
+		r:          r,
+		generation: hdrBuff.NDBGeneration,
+		slots:      slots,
+	}, nil
+}
+
+// Metadata is the information kept in the header of an NDB database.
+type Metadata struct {
+	Version    uint32
+	Generation uint32
+	SlotPages  uint32
+}
+
+// ReadMetadata reads the header of the NDB database held in r.
+func ReadMetadata(r io.Reader) (*Metadata, error) {
+	hdr, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Metadata{
+		Version:    hdr.NDBVersion,
+		Generation: hdr.NDBGeneration,
+		SlotPages:  hdr.SlotNPages,
 	}, nil
 }
 
+func readHeader(r io.Reader) (*ndbHeader, error) {
+	hdrBuff := ndbHeader{}
+	err := binary.Read(r, binary.LittleEndian, &hdrBuff)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read metadata: %w", err)
+	}
+
+	if hdrBuff.HeaderMagic != NDB_HeaderMagic || hdrBuff.SlotNPages == 0 ||
+		hdrBuff.NDBVersion != NDB_DBVersion {
+		return nil, ErrorInvalidNDB
+	}
+	return &hdrBuff, nil
+}
+
 func (db *RpmNDB) Close() error {
+	if db.file == nil {
+		return nil
+	}
 	_ = syscallFlock(int(db.file.Fd()), syscallLOCK_UN)
 	return db.file.Close()
 }
 
 func (db *RpmNDB) Read() <-chan dbi.Entry {
+	return db.ReadContext(context.Background())
+}
+
+func (db *RpmNDB) ReadContext(ctx context.Context) <-chan dbi.Entry {
 	entries := make(chan dbi.Entry)
 
 	go func() {
@@ -145,12 +210,16 @@ func (db *RpmNDB) Read() <-chan dbi.Entry {
 		const NDB_BlobHeaderSize = int64(unsafe.Sizeof(ndbBlobHeader{}))
 
 		for _, slot := range db.slots {
+			if ctx.Err() != nil {
+				return
+			}
+
 			const NDB_SlotMagic = 'S' | 'l'<<8 | 'o'<<16 | 't'<<24
 			if slot.SlotMagic != NDB_SlotMagic {
 				fmt.Println("bad slot magic", slot.SlotMagic)
-				entries <- dbi.Entry{
+				dbi.Send(ctx, entries, dbi.Entry{
 					Err: xerrors.Errorf("bad slot Magic: %x", slot.SlotMagic),
-				}
+				})
 				return
 			}
 			// Empty slot?
@@ -158,45 +227,126 @@ func (db *RpmNDB) Read() <-chan dbi.Entry {
 				continue
 			}
 			// Seek to Blob
-			_, err := db.file.Seek(int64(slot.BlkOffset)*NDB_BlobHeaderSize, io.SeekStart)
-			if err != nil {
-				entries <- dbi.Entry{
-					Err: err,
-				}
-				return
-			}
+			blob := io.NewSectionReader(db.r, int64(slot.BlkOffset)*NDB_BlobHeaderSize, int64(slot.BlkCount)*NDB_BlobHeaderSize)
 
 			// Read Blob Header
 			blobHeaderBuff := ndbBlobHeader{}
-			err = binary.Read(db.file, binary.LittleEndian, &blobHeaderBuff)
+			err := binary.Read(blob, binary.LittleEndian, &blobHeaderBuff)
 			if err != nil {
-				entries <- dbi.Entry{
+				dbi.Send(ctx, entries, dbi.Entry{
 					Err: err,
-				}
+				})
 				return
 			}
 			const NDB_BlobMagic = 'B' | 'l'<<8 | 'b'<<16 | 'S'<<24
 			if blobHeaderBuff.BlobMagic != NDB_BlobMagic {
-				entries <- dbi.Entry{
+				if !dbi.Send(ctx, entries, dbi.Entry{
 					Err: xerrors.Errorf("unexpected NDB blob Magic for pkg %d: %x", slot.PkgIndex, blobHeaderBuff.BlobMagic),
+				}) {
+					return
 				}
 			}
 			if blobHeaderBuff.PkgIndex != slot.PkgIndex {
-				entries <- dbi.Entry{
+				if !dbi.Send(ctx, entries, dbi.Entry{
 					Err: xerrors.Errorf("failed to find NDB blob for pkg %d", slot.PkgIndex),
+				}) {
+					return
 				}
 			}
 			// ### check that BlkCnt == (BLOBHEAD_SIZE + bloblen + BLOBTAIL_SIZE + PKGDB_BLK_SIZE - 1) / PKGDB_BLK_SIZE)
 
 			// Read Blob Content
 			BlobEntry := make([]byte, blobHeaderBuff.BlobLen)
-			_, err = db.file.Read(BlobEntry)
-			entries <- dbi.Entry{
-				Value: BlobEntry,
-				Err:   err,
+			_, err = io.ReadFull(blob, BlobEntry)
+			if !dbi.Send(ctx, entries, dbi.Entry{
+				HeaderNum: slot.PkgIndex,
+				Value:     BlobEntry,
+				Err:       err,
+			}) {
+				return
 			}
 		}
 	}()
 
 	return entries
 }
+
+// indexTags are the rpm tags Index.db keeps the indexes under.
+var indexTags = map[dbi.Index]uint32{
+	dbi.IndexName:        1000, // RPMTAG_NAME
+	dbi.IndexBasenames:   1117, // RPMTAG_BASENAMES
+	dbi.IndexProvidename: 1047, // RPMTAG_PROVIDENAME
+}
+
+// LookupContext returns the PkgIndex of the packages stored under key in the
+// index of Index.db next to Packages.db. Like rpm, it does not trust an index
+// whose generation differs from the one of Packages.db.
+// ref. ndb_CheckIndexSync() in https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/backend/ndb/glue.c
+func (db *RpmNDB) LookupContext(ctx context.Context, index dbi.Index, key string) ([]uint32, error) {
+	tag, ok := indexTags[index]
+	if !ok || db.dir == "" {
+		return nil, xerrors.Errorf("%s: %w", index, dbi.ErrorIndexNotFound)
+	}
+	idx, err := OpenIndex(filepath.Join(db.dir, "Index.db"))
+	if xerrors.Is(err, fs.ErrNotExist) {
+		return nil, xerrors.Errorf("%s: %w", index, dbi.ErrorIndexNotFound)
+	} else if err != nil {
+		return nil, xerrors.Errorf("failed to open Index.db: %w", err)
+	}
+	defer idx.Close()
+
+	if idx.UserGeneration != db.generation {
+		return nil, xerrors.Errorf("%s: outdated generation %d, Packages.db is at %d: %w",
+			index, idx.UserGeneration, db.generation, dbi.ErrorIndexNotFound)
+	}
+
+	items, err := idx.LookupContext(ctx, tag, []byte(key))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to look up %s in %s: %w", key, index, err)
+	}
+
+	var hnums []uint32
+	seen := make(map[uint32]struct{})
+	for _, item := range items {
+		if _, ok := seen[item.HeaderNum]; !ok {
+			seen[item.HeaderNum] = struct{}{}
+			hnums = append(hnums, item.HeaderNum)
+		}
+	}
+	return hnums, nil
+}
+
+// GetContext reads the blob of the package with the given PkgIndex from its slot.
+func (db *RpmNDB) GetContext(ctx context.Context, hnum uint32) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	const NDB_BlobHeaderSize = int64(unsafe.Sizeof(ndbBlobHeader{}))
+
+	for _, slot := range db.slots {
+		if hnum == 0 || slot.PkgIndex != hnum {
+			continue
+		}
+		blob := io.NewSectionReader(db.r, int64(slot.BlkOffset)*NDB_BlobHeaderSize, int64(slot.BlkCount)*NDB_BlobHeaderSize)
+
+		blobHeaderBuff := ndbBlobHeader{}
+		if err := binary.Read(blob, binary.LittleEndian, &blobHeaderBuff); err != nil {
+			return nil, err
+		}
+		const NDB_BlobMagic = 'B' | 'l'<<8 | 'b'<<16 | 'S'<<24
+		if blobHeaderBuff.BlobMagic != NDB_BlobMagic {
+			return nil, xerrors.Errorf("unexpected NDB blob Magic for pkg %d: %x", slot.PkgIndex, blobHeaderBuff.BlobMagic)
+		}
+		if blobHeaderBuff.PkgIndex != slot.PkgIndex {
+			return nil, xerrors.Errorf("failed to find NDB blob for pkg %d", slot.PkgIndex)
+		}
+
+		BlobEntry := make([]byte, blobHeaderBuff.BlobLen)
+		if _, err := io.ReadFull(blob, BlobEntry); err != nil {
+			return nil, err
+		}
+		return BlobEntry, nil
+	}
+	return nil, xerrors.Errorf("pkg %d: %w", hnum, dbi.ErrorHeaderNotFound)
+}
@@ -0,0 +1,799 @@
+package rpmdb
+
+import (
+	"bufio"
+	"bytes"
+	"crypto"
+	"crypto/dsa"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// OpenPGP packet tags, public key algorithms and hash algorithms
+// ref. https://www.rfc-editor.org/rfc/rfc4880
+const (
+	pgpTagSignature     = 2
+	pgpTagPublicKey     = 6
+	pgpTagUserID        = 13
+	pgpTagPublicSub     = 14
+	pgpTagUserAttribute = 17
+
+	pgpPubKeyRSA     = 1
+	pgpPubKeyRSASign = 3
+	pgpPubKeyDSA     = 17
+	pgpPubKeyECDSA   = 19
+	pgpPubKeyEdDSA   = 22
+	pgpPubKeyEd25519 = 27
+
+	pgpSubpacketCreationTime      = 2
+	pgpSubpacketKeyExpirationTime = 9
+	pgpSubpacketIssuer            = 16
+	pgpSubpacketKeyFlags          = 27
+	pgpSubpacketIssuerFingerprint = 33
+
+	pgpSigBinary           = 0x00 // signature of a binary document
+	pgpSigGenericCert      = 0x10 // up to 0x13, certification of a user ID
+	pgpSigPositiveCert     = 0x13
+	pgpSigSubkeyBinding    = 0x18
+	pgpSigDirectKey        = 0x1f
+	pgpSigKeyRevocation    = 0x20
+	pgpSigSubkeyRevocation = 0x28
+
+	pgpKeyFlagSign = 0x02
+)
+
+// pgpHashes are the hash algorithms signatures may use. MD5 is left out, as rpm
+// refuses it.
+var pgpHashes = map[uint8]crypto.Hash{
+	PGPHASHALGO_SHA1:   crypto.SHA1,
+	PGPHASHALGO_SHA256: crypto.SHA256,
+	PGPHASHALGO_SHA384: crypto.SHA384,
+	PGPHASHALGO_SHA512: crypto.SHA512,
+	PGPHASHALGO_SHA224: crypto.SHA224,
+}
+
+// OIDs of the elliptic curves, without the DER tag and length
+// ref. https://www.rfc-editor.org/rfc/rfc6637#section-11
+var pgpCurves = map[string]elliptic.Curve{
+	"2a8648ce3d030107": elliptic.P256(),
+	"2b81040022":       elliptic.P384(),
+	"2b81040023":       elliptic.P521(),
+}
+
+var pgpCurvesECDH = map[string]ecdh.Curve{
+	"2a8648ce3d030107": ecdh.P256(),
+	"2b81040022":       ecdh.P384(),
+	"2b81040023":       ecdh.P521(),
+}
+
+// ref. https://www.rfc-editor.org/rfc/rfc9580#section-9.2
+const pgpOIDEd25519Legacy = "2b06010401da470f01"
+
+// Keyring is a set of OpenPGP public keys to verify package signatures with. Only
+// version 4 keys are supported, which covers every key rpm distributions sign
+// with. A primary key is trusted just by being in the keyring, like rpm does with
+// the keys imported into the rpmdb, but its self-signatures are checked: a key
+// does not verify signatures made after it expired, nor any signature once it is
+// revoked, and a subkey is only used if the primary key bound it for signing.
+// Revocations issued by designated revokers are not supported.
+type Keyring struct {
+	keys map[uint64][]*pgpPublicKey // key ID => primary keys and subkeys
+}
+
+func NewKeyring() *Keyring {
+	return &Keyring{keys: make(map[uint64][]*pgpPublicKey)}
+}
+
+// ReadArmored adds the keys of every ASCII armored public key block in r, e.g. a
+// file of /etc/pki/rpm-gpg.
+func (k *Keyring) ReadArmored(r io.Reader) error {
+	blocks, err := decodeArmor(r)
+	if err != nil {
+		return err
+	}
+	if len(blocks) == 0 {
+		return xerrors.New("no public key block found")
+	}
+	for _, block := range blocks {
+		if err := k.add(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read adds the keys of the binary OpenPGP packets in r, e.g. a keyring exported
+// by gpg --export.
+func (k *Keyring) Read(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return xerrors.Errorf("failed to read keyring: %w", err)
+	}
+	return k.add(data)
+}
+
+// KeyIDs returns the IDs of the keys in the keyring as 16 hex digits, the way rpm
+// prints them.
+func (k *Keyring) KeyIDs() []string {
+	var ids []string
+	for id := range k.keys {
+		ids = append(ids, formatKeyID(id))
+	}
+	return ids
+}
+
+func (k *Keyring) add(data []byte) error {
+	packets, err := readPGPPackets(data)
+	if err != nil {
+		return err
+	}
+
+	// a transferable public key is a primary key followed by its user IDs and
+	// subkeys, each with their signatures
+	// ref. https://www.rfc-editor.org/rfc/rfc4880#section-11.1
+	var primary, subkey *pgpPublicKey
+	var subkeys []*pgpPublicKey
+	var userID []byte // the user ID or user attribute packet being certified
+	var userIDTag uint8
+	flush := func() {
+		if primary != nil {
+			k.keys[primary.keyID] = append(k.keys[primary.keyID], primary)
+		}
+		for _, sub := range subkeys {
+			if sub.bound {
+				k.keys[sub.keyID] = append(k.keys[sub.keyID], sub)
+			}
+		}
+		primary, subkey, subkeys, userID = nil, nil, nil, nil
+	}
+
+	for _, p := range packets {
+		switch p.tag {
+		case pgpTagPublicKey:
+			flush()
+			key, err := parsePGPPublicKey(p.body)
+			if err != nil {
+				return xerrors.Errorf("invalid public key: %w", err)
+			}
+			primary = key
+		case pgpTagPublicSub:
+			key, err := parsePGPPublicKey(p.body)
+			if err != nil {
+				return xerrors.Errorf("invalid public key: %w", err)
+			}
+			subkey, userID = key, nil
+			if key != nil && primary != nil {
+				key.primary = primary
+				subkeys = append(subkeys, key)
+			}
+		case pgpTagUserID, pgpTagUserAttribute:
+			subkey, userID, userIDTag = nil, p.body, p.tag
+		case pgpTagSignature:
+			if primary == nil {
+				continue
+			}
+			// signatures which cannot be parsed or verified are ignored
+			sig, err := parsePGPSignaturePacket(p.body)
+			if err != nil || sig.keyID != 0 && sig.keyID != primary.keyID {
+				continue
+			}
+			primary.selfSignature(sig, subkey, userIDTag, userID)
+		}
+	}
+	flush()
+	return nil
+}
+
+// selfSignature applies the signature sig of the primary key, which follows the
+// given subkey, or user ID packet of type tag, in a transferable public key.
+// source: pgpVerifySelf() in https://github.com/rpm-software-management/rpm/blob/rpm-4.18.0-release/rpmio/rpmpgp_internal.c
+func (primary *pgpPublicKey) selfSignature(sig *pgpSignature, subkey *pgpPublicKey, tag uint8, userID []byte) {
+	data := primary.hashPrefix()
+	switch {
+	case subkey == nil && userID != nil && sig.sigType >= pgpSigGenericCert && sig.sigType <= pgpSigPositiveCert:
+		// version 3 signatures hash the bare user ID
+		if sig.version == 4 {
+			prefix := byte(0xb4)
+			if tag == pgpTagUserAttribute {
+				prefix = 0xd1
+			}
+			data = binary.BigEndian.AppendUint32(append(data, prefix), uint32(len(userID)))
+		}
+		data = append(data, userID...)
+	case subkey == nil && userID == nil && (sig.sigType == pgpSigDirectKey || sig.sigType == pgpSigKeyRevocation):
+	case subkey != nil && (sig.sigType == pgpSigSubkeyBinding || sig.sigType == pgpSigSubkeyRevocation):
+		data = append(data, subkey.hashPrefix()...)
+	default:
+		return
+	}
+	if sig.check(primary, data) != nil {
+		return
+	}
+
+	switch sig.sigType {
+	case pgpSigKeyRevocation:
+		primary.revoked = true
+	case pgpSigSubkeyRevocation:
+		subkey.revoked = true
+	case pgpSigSubkeyBinding:
+		// the most recent binding signature is the one in effect
+		if sig.created < subkey.selfSigned {
+			return
+		}
+		subkey.selfSigned = sig.created
+		subkey.expires = keyExpiration(subkey, sig)
+		subkey.bound = !sig.hasKeyFlags || sig.keyFlags&pgpKeyFlagSign != 0
+	default:
+		if sig.created < primary.selfSigned {
+			return
+		}
+		primary.selfSigned = sig.created
+		primary.expires = keyExpiration(primary, sig)
+	}
+}
+
+// keyExpiration returns when key expires according to its self-signature sig,
+// in seconds since the epoch, or 0 if it does not expire.
+func keyExpiration(key *pgpPublicKey, sig *pgpSignature) uint64 {
+	if sig.keyExpiration == 0 {
+		return 0
+	}
+	return uint64(key.created) + uint64(sig.keyExpiration)
+}
+
+func formatKeyID(id uint64) string {
+	return hex.EncodeToString(binary.BigEndian.AppendUint64(nil, id))
+}
+
+type pgpPacket struct {
+	tag  uint8
+	body []byte
+}
+
+// readPGPPackets splits data into packets, in the old or in the new format.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-4.2
+func readPGPPackets(data []byte) ([]pgpPacket, error) {
+	var packets []pgpPacket
+	for len(data) > 0 {
+		ptag := data[0]
+		if ptag&0x80 == 0 {
+			return nil, xerrors.Errorf("invalid packet tag: %#x", ptag)
+		}
+
+		var tag uint8
+		var length, hlen int
+		if ptag&0x40 != 0 {
+			tag = ptag & 0x3f
+			if len(data) < 2 {
+				return nil, xerrors.New("short packet header")
+			}
+			switch o := int(data[1]); {
+			case o < 192:
+				length, hlen = o, 2
+			case o < 224:
+				if len(data) < 3 {
+					return nil, xerrors.New("short packet header")
+				}
+				length, hlen = (o-192)<<8+int(data[2])+192, 3
+			case o == 255:
+				if len(data) < 6 {
+					return nil, xerrors.New("short packet header")
+				}
+				length, hlen = int(binary.BigEndian.Uint32(data[2:])), 6
+			default:
+				return nil, xerrors.New("partial body lengths are not supported")
+			}
+		} else {
+			tag = (ptag >> 2) & 0x0f
+			switch ptag & 0x03 {
+			case 0:
+				if len(data) < 2 {
+					return nil, xerrors.New("short packet header")
+				}
+				length, hlen = int(data[1]), 2
+			case 1:
+				if len(data) < 3 {
+					return nil, xerrors.New("short packet header")
+				}
+				length, hlen = int(binary.BigEndian.Uint16(data[1:])), 3
+			case 2:
+				if len(data) < 5 {
+					return nil, xerrors.New("short packet header")
+				}
+				length, hlen = int(binary.BigEndian.Uint32(data[1:])), 5
+			default:
+				// indeterminate length, up to the end of the data
+				length, hlen = len(data)-1, 1
+			}
+		}
+
+		if length < 0 || hlen+length > len(data) {
+			return nil, xerrors.Errorf("invalid packet length: %d", length)
+		}
+		packets = append(packets, pgpPacket{tag: tag, body: data[hlen : hlen+length]})
+		data = data[hlen+length:]
+	}
+	return packets, nil
+}
+
+// decodeArmor returns the contents of the public key blocks and signature blocks
+// in r.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-6.2
+func decodeArmor(r io.Reader) ([][]byte, error) {
+	var blocks [][]byte
+	var body strings.Builder
+	var checksum string
+	inBlock, inHeaders := false, false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case !inBlock:
+			if strings.HasPrefix(line, "-----BEGIN PGP ") {
+				inBlock, inHeaders = true, true
+				body.Reset()
+				checksum = ""
+			}
+		case strings.HasPrefix(line, "-----END PGP "):
+			data, err := base64.StdEncoding.DecodeString(body.String())
+			if err != nil {
+				return nil, xerrors.Errorf("invalid armor: %w", err)
+			}
+			if checksum != "" {
+				if want, err := base64.StdEncoding.DecodeString(checksum); err != nil || !bytes.Equal(want, crc24(data)) {
+					return nil, xerrors.New("armor checksum mismatch")
+				}
+			}
+			blocks = append(blocks, data)
+			inBlock = false
+		case inHeaders && strings.Contains(line, ": "):
+		case inHeaders && line == "":
+			inHeaders = false
+		case strings.HasPrefix(line, "=") && len(line) == 5:
+			checksum = line[1:]
+		default:
+			inHeaders = false
+			body.WriteString(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to read armor: %w", err)
+	}
+	if inBlock {
+		return nil, xerrors.New("unterminated armor")
+	}
+	return blocks, nil
+}
+
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-6.1
+func crc24(data []byte) []byte {
+	crc := uint32(0xb704ce)
+	for _, b := range data {
+		crc ^= uint32(b) << 16
+		for i := 0; i < 8; i++ {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= 0x1864cfb
+			}
+		}
+	}
+	return []byte{byte(crc >> 16), byte(crc >> 8), byte(crc)}
+}
+
+// pgpPublicKey is a primary key or a subkey.
+type pgpPublicKey struct {
+	keyID   uint64
+	algo    uint8
+	public  crypto.PublicKey
+	body    []byte // the public key packet
+	created uint32
+
+	// set from the self-signatures
+	primary    *pgpPublicKey // the primary key of a subkey
+	selfSigned uint32        // creation time of the self-signature in effect
+	expires    uint64        // 0 if the key does not expire
+	revoked    bool
+	bound      bool // a subkey with a valid binding signature for signing
+}
+
+// hashPrefix returns the public key packet with an old format header, as it is
+// hashed for the fingerprint and for signatures over the key.
+func (key *pgpPublicKey) hashPrefix() []byte {
+	prefix := []byte{0x99, byte(len(key.body) >> 8), byte(len(key.body))}
+	return append(prefix, key.body...)
+}
+
+// validAt reports an error if key cannot verify a signature created at t, being
+// revoked or expired, or its primary key being so.
+func (key *pgpPublicKey) validAt(t uint32) error {
+	for k := key; k != nil; k = k.primary {
+		if k.revoked {
+			return xerrors.Errorf("key %s is revoked", formatKeyID(k.keyID))
+		}
+		if k.expires != 0 && uint64(t) >= k.expires {
+			return xerrors.Errorf("key %s expired before the signature was made", formatKeyID(k.keyID))
+		}
+	}
+	return nil
+}
+
+// parsePGPPublicKey decodes a version 4 public key packet. Keys of other versions
+// or with an unsupported algorithm are skipped, returning nil.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-5.5.2
+func parsePGPPublicKey(body []byte) (*pgpPublicKey, error) {
+	if len(body) < 6 {
+		return nil, xerrors.New("short public key packet")
+	}
+	if body[0] != 4 {
+		return nil, nil
+	}
+
+	key := &pgpPublicKey{
+		algo:    body[5],
+		body:    body,
+		created: binary.BigEndian.Uint32(body[1:]),
+	}
+	// the fingerprint is the SHA1 of the packet with an old format header
+	fingerprint := sha1.Sum(key.hashPrefix())
+	key.keyID = binary.BigEndian.Uint64(fingerprint[12:])
+
+	r := &mpiReader{data: body[6:]}
+	switch key.algo {
+	case pgpPubKeyRSA, pgpPubKeyRSASign:
+		n, e := r.int(), r.int()
+		if r.err != nil {
+			return nil, r.err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, xerrors.New("invalid RSA exponent")
+		}
+		key.public = &rsa.PublicKey{N: n, E: int(e.Int64())}
+	case pgpPubKeyDSA:
+		p, q, g, y := r.int(), r.int(), r.int(), r.int()
+		if r.err != nil {
+			return nil, r.err
+		}
+		key.public = &dsa.PublicKey{Parameters: dsa.Parameters{P: p, Q: q, G: g}, Y: y}
+	case pgpPubKeyECDSA:
+		oid, point := r.oid(), r.mpi()
+		if r.err != nil {
+			return nil, r.err
+		}
+		curve, ok := pgpCurves[oid]
+		if !ok {
+			return nil, nil
+		}
+		// the ecdh package checks that the point is on the curve
+		if _, err := pgpCurvesECDH[oid].NewPublicKey(point); err != nil {
+			return nil, xerrors.Errorf("invalid ECDSA point: %w", err)
+		}
+		size := (len(point) - 1) / 2
+		key.public = &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(point[1 : 1+size]),
+			Y:     new(big.Int).SetBytes(point[1+size:]),
+		}
+	case pgpPubKeyEdDSA:
+		oid, point := r.oid(), r.mpi()
+		if r.err != nil {
+			return nil, r.err
+		}
+		if oid != pgpOIDEd25519Legacy {
+			return nil, nil
+		}
+		// the point is prefixed with 0x40
+		if len(point) != 1+ed25519.PublicKeySize || point[0] != 0x40 {
+			return nil, xerrors.New("invalid EdDSA point")
+		}
+		key.public = ed25519.PublicKey(point[1:])
+	case pgpPubKeyEd25519:
+		if len(r.data) < ed25519.PublicKeySize {
+			return nil, xerrors.New("short Ed25519 key")
+		}
+		key.public = ed25519.PublicKey(r.data[:ed25519.PublicKeySize])
+	default:
+		return nil, nil
+	}
+	return key, nil
+}
+
+// mpiReader reads the multiprecision integers and OIDs of key and signature
+// packets, keeping the first error.
+type mpiReader struct {
+	data []byte
+	err  error
+}
+
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-3.2
+func (r *mpiReader) mpi() []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.data) < 2 {
+		r.err = xerrors.New("short MPI")
+		return nil
+	}
+	n := (int(binary.BigEndian.Uint16(r.data)) + 7) / 8
+	if len(r.data) < 2+n {
+		r.err = xerrors.Errorf("short MPI: %d bytes", n)
+		return nil
+	}
+	b := r.data[2 : 2+n]
+	r.data = r.data[2+n:]
+	return b
+}
+
+func (r *mpiReader) int() *big.Int {
+	return new(big.Int).SetBytes(r.mpi())
+}
+
+func (r *mpiReader) oid() string {
+	if r.err != nil {
+		return ""
+	}
+	if len(r.data) < 1 || len(r.data) < 1+int(r.data[0]) {
+		r.err = xerrors.New("short OID")
+		return ""
+	}
+	oid := r.data[1 : 1+int(r.data[0])]
+	r.data = r.data[1+len(oid):]
+	return hex.EncodeToString(oid)
+}
+
+// pgpSignature is a version 3 or version 4 signature packet.
+type pgpSignature struct {
+	version  uint8
+	keyID    uint64
+	created  uint32
+	sigType  uint8
+	pubAlgo  uint8
+	hashAlgo uint8
+	suffix   []byte // hashed after the signed data
+	left16   []byte // the first two bytes of the digest
+	material []byte // the algorithm specific fields
+
+	// from the hashed subpackets of a self-signature
+	keyExpiration uint32 // seconds after the key creation, 0 if it does not expire
+	keyFlags      uint8
+	hasKeyFlags   bool
+}
+
+// parsePGPSignature decodes the signature packet of RPMTAG_RSAHEADER,
+// RPMTAG_DSAHEADER or RPMTAG_OPENPGP.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-5.2
+func parsePGPSignature(data []byte) (*pgpSignature, error) {
+	packets, err := readPGPPackets(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(packets) != 1 || packets[0].tag != pgpTagSignature {
+		return nil, xerrors.New("not a signature packet")
+	}
+	return parsePGPSignaturePacket(packets[0].body)
+}
+
+// parsePGPSignaturePacket decodes the body of a signature packet.
+func parsePGPSignaturePacket(body []byte) (*pgpSignature, error) {
+	if len(body) < 1 {
+		return nil, xerrors.New("short signature packet")
+	}
+
+	sig := &pgpSignature{version: body[0]}
+	switch body[0] {
+	case 3:
+		// version, hashed length (always 5), type, time, key ID, algorithms, left16
+		if len(body) < 19 || body[1] != 5 {
+			return nil, xerrors.New("invalid v3 signature packet")
+		}
+		sig.suffix = body[2:7]
+		sig.sigType = body[2]
+		sig.created = binary.BigEndian.Uint32(body[3:])
+		sig.keyID = binary.BigEndian.Uint64(body[7:])
+		sig.pubAlgo, sig.hashAlgo = body[15], body[16]
+		sig.left16 = body[17:19]
+		sig.material = body[19:]
+	case 4:
+		if len(body) < 6 {
+			return nil, xerrors.New("short v4 signature packet")
+		}
+		sig.sigType, sig.pubAlgo, sig.hashAlgo = body[1], body[2], body[3]
+		hashedEnd := 6 + int(binary.BigEndian.Uint16(body[4:]))
+		if len(body) < hashedEnd+2 {
+			return nil, xerrors.New("invalid hashed subpackets length")
+		}
+		unhashedEnd := hashedEnd + 2 + int(binary.BigEndian.Uint16(body[hashedEnd:]))
+		if len(body) < unhashedEnd+2 {
+			return nil, xerrors.New("invalid unhashed subpackets length")
+		}
+
+		// the hashed part of the packet, then a trailer with its length
+		sig.suffix = append([]byte{}, body[:hashedEnd]...)
+		sig.suffix = append(sig.suffix, 0x04, 0xff)
+		sig.suffix = binary.BigEndian.AppendUint32(sig.suffix, uint32(hashedEnd))
+
+		for _, subpackets := range [][]byte{body[6:hashedEnd], body[hashedEnd+2 : unhashedEnd]} {
+			if keyID, ok := issuerKeyID(subpackets); ok {
+				sig.keyID = keyID
+				break
+			}
+		}
+		// only the hashed subpackets are covered by the signature
+		pgpSubpackets(body[6:hashedEnd], func(typ uint8, sub []byte) bool {
+			switch {
+			case typ == pgpSubpacketCreationTime && len(sub) == 4:
+				sig.created = binary.BigEndian.Uint32(sub)
+			case typ == pgpSubpacketKeyExpirationTime && len(sub) == 4:
+				sig.keyExpiration = binary.BigEndian.Uint32(sub)
+			case typ == pgpSubpacketKeyFlags && len(sub) >= 1:
+				sig.keyFlags, sig.hasKeyFlags = sub[0], true
+			}
+			return true
+		})
+		sig.left16 = body[unhashedEnd : unhashedEnd+2]
+		sig.material = body[unhashedEnd+2:]
+	default:
+		return nil, xerrors.Errorf("unsupported signature version: %d", body[0])
+	}
+	return sig, nil
+}
+
+// issuerKeyID finds the issuer or issuer fingerprint subpacket.
+func issuerKeyID(data []byte) (uint64, bool) {
+	var keyID uint64
+	var found bool
+	pgpSubpackets(data, func(typ uint8, sub []byte) bool {
+		switch {
+		case typ == pgpSubpacketIssuer && len(sub) == 8:
+			keyID, found = binary.BigEndian.Uint64(sub), true
+		case typ == pgpSubpacketIssuerFingerprint && len(sub) == 21 && sub[0] == 4:
+			// version 4 fingerprints end with the key ID
+			keyID, found = binary.BigEndian.Uint64(sub[13:]), true
+		}
+		return !found
+	})
+	return keyID, found
+}
+
+// pgpSubpackets calls f with the type and the contents of each subpacket in data
+// until f returns false or a subpacket is malformed.
+// ref. https://www.rfc-editor.org/rfc/rfc4880#section-5.2.3.1
+func pgpSubpackets(data []byte, f func(typ uint8, sub []byte) bool) {
+	for len(data) > 0 {
+		var length, hlen int
+		switch o := int(data[0]); {
+		case o < 192:
+			length, hlen = o, 1
+		case o < 255 && len(data) >= 2:
+			length, hlen = (o-192)<<8+int(data[1])+192, 2
+		case o == 255 && len(data) >= 5:
+			length, hlen = int(binary.BigEndian.Uint32(data[1:])), 5
+		default:
+			return
+		}
+		if length < 1 || hlen+length > len(data) {
+			return
+		}
+		sub := data[hlen : hlen+length]
+		// the high bit of the type marks a critical subpacket
+		if !f(sub[0]&0x7f, sub[1:]) {
+			return
+		}
+		data = data[hlen+length:]
+	}
+}
+
+// verify checks the signature of the header data with key.
+func (sig *pgpSignature) verify(key *pgpPublicKey, data []byte) error {
+	// a header is signed as a binary document
+	if sig.sigType != pgpSigBinary {
+		return xerrors.Errorf("unexpected signature type: %#02x", sig.sigType)
+	}
+	if err := key.validAt(sig.created); err != nil {
+		return err
+	}
+	return sig.check(key, data)
+}
+
+// check checks the signature of data with key, whatever the signature type.
+func (sig *pgpSignature) check(key *pgpPublicKey, data []byte) error {
+	if pgpKeyAlgo(sig.pubAlgo) != pgpKeyAlgo(key.algo) {
+		return xerrors.Errorf("signature algorithm %d does not match key algorithm %d", sig.pubAlgo, key.algo)
+	}
+	hash, ok := pgpHashes[sig.hashAlgo]
+	if !ok || !hash.Available() {
+		return xerrors.Errorf("unsupported hash algorithm: %d", sig.hashAlgo)
+	}
+	h := hash.New()
+	h.Write(data)
+	h.Write(sig.suffix)
+	digest := h.Sum(nil)
+	if !bytes.Equal(digest[:2], sig.left16) {
+		return xerrors.New("digest mismatch")
+	}
+
+	r := &mpiReader{data: sig.material}
+	switch pub := key.public.(type) {
+	case *rsa.PublicKey:
+		s := r.mpi()
+		if r.err != nil {
+			return r.err
+		}
+		// the leading zeros of the signature are dropped by the MPI encoding
+		size := (pub.N.BitLen() + 7) / 8
+		if len(s) > size {
+			return xerrors.New("invalid RSA signature length")
+		}
+		padded := make([]byte, size)
+		copy(padded[size-len(s):], s)
+		return rsa.VerifyPKCS1v15(pub, hash, digest, padded)
+	case *dsa.PublicKey:
+		rr, s := r.int(), r.int()
+		if r.err != nil {
+			return r.err
+		}
+		// the digest is truncated to the size of q
+		if n := pub.Q.BitLen() / 8; len(digest) > n {
+			digest = digest[:n]
+		}
+		if !dsa.Verify(pub, digest, rr, s) {
+			return xerrors.New("invalid DSA signature")
+		}
+	case *ecdsa.PublicKey:
+		rr, s := r.int(), r.int()
+		if r.err != nil {
+			return r.err
+		}
+		if !ecdsa.Verify(pub, digest, rr, s) {
+			return xerrors.New("invalid ECDSA signature")
+		}
+	case ed25519.PublicKey:
+		var signature []byte
+		if key.algo == pgpPubKeyEd25519 {
+			if len(sig.material) < ed25519.SignatureSize {
+				return xerrors.New("short Ed25519 signature")
+			}
+			signature = sig.material[:ed25519.SignatureSize]
+		} else {
+			rr, s := r.mpi(), r.mpi()
+			if r.err != nil {
+				return r.err
+			}
+			if len(rr) > 32 || len(s) > 32 {
+				return xerrors.New("invalid EdDSA signature length")
+			}
+			signature = make([]byte, ed25519.SignatureSize)
+			copy(signature[32-len(rr):], rr)
+			copy(signature[64-len(s):], s)
+		}
+		if !ed25519.Verify(pub, digest, signature) {
+			return xerrors.New("invalid EdDSA signature")
+		}
+	default:
+		return xerrors.Errorf("unsupported public key algorithm: %d", key.algo)
+	}
+	return nil
+}
+
+// pgpKeyAlgo maps the deprecated sign-only RSA algorithm to RSA, which both
+// describe the same keys and signatures.
+func pgpKeyAlgo(algo uint8) uint8 {
+	if algo == pgpPubKeyRSASign {
+		return pgpPubKeyRSA
+	}
+	return algo
+}
@@ -0,0 +1,152 @@
+package rpmdb
+
+import (
+	"context"
+	"path"
+	"strings"
+)
+
+// FileOwner is a package owning a path, along with the file entry it owns.
+// Several packages may own the same directory, and a file marked with
+// RPMFILE_GHOST in File.Flags is owned even though the package does not ship it.
+type FileOwner struct {
+	Package *PackageInfo
+	File    FileInfo
+}
+
+// IsGhost reports whether the package owns the file without shipping it.
+func (o FileOwner) IsGhost() bool {
+	return int32(o.File.Flags)&RPMFILE_GHOST != 0
+}
+
+// WhatOwns returns the packages owning the absolute path p, like rpm -qf. The
+// symbolic links to directories installed by packages, such as /lib pointing to
+// usr/lib, are followed, so /lib/libfoo.so and /usr/lib/libfoo.so have the same
+// owners. An unowned path returns no owners and no error.
+func (d *RpmDB) WhatOwns(p string) ([]FileOwner, error) {
+	return d.WhatOwnsContext(context.Background(), p)
+}
+
+// WhatOwnsContext is like WhatOwns, but gives up once ctx is done.
+func (d *RpmDB) WhatOwnsContext(ctx context.Context, p string) ([]FileOwner, error) {
+	idx, err := d.fileIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return idx.whatOwns(p), nil
+}
+
+// fileIndex returns the index of all installed files, building it on first use.
+func (d *RpmDB) fileIndex(ctx context.Context) (*fileIndex, error) {
+	pkgIdx, err := d.packageIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.indexMu.Lock()
+	defer d.indexMu.Unlock()
+
+	if d.files == nil {
+		if d.files, err = newFileIndex(pkgIdx.packages); err != nil {
+			return nil, err
+		}
+	}
+	return d.files, nil
+}
+
+// fileIndex maps the installed paths to their owners.
+type fileIndex struct {
+	symlinks map[string]string // packaged symbolic links and their targets
+	owners   map[string][]FileOwner
+}
+
+func newFileIndex(pkgList []*PackageInfo) (*fileIndex, error) {
+	idx := &fileIndex{
+		symlinks: make(map[string]string),
+		owners:   make(map[string][]FileOwner),
+	}
+
+	files := make([][]FileInfo, len(pkgList))
+	for i, pkg := range pkgList {
+		var err error
+		if files[i], err = pkg.InstalledFiles(); err != nil {
+			return nil, err
+		}
+		for _, file := range files[i] {
+			if file.Mode&fileTypeMask != fileTypeSymlink || file.LinkTo == "" {
+				continue
+			}
+			if _, ok := idx.symlinks[file.Path]; !ok {
+				idx.symlinks[file.Path] = file.LinkTo
+			}
+		}
+	}
+
+	// the symbolic links must be known before the paths can be resolved
+	for i, pkg := range pkgList {
+		for _, file := range files[i] {
+			p := idx.resolve(file.Path)
+			idx.owners[p] = append(idx.owners[p], FileOwner{Package: pkg, File: file})
+		}
+	}
+	return idx, nil
+}
+
+func (idx *fileIndex) whatOwns(p string) []FileOwner {
+	return idx.owners[idx.resolve(p)]
+}
+
+// source: https://github.com/torvalds/linux/blob/v6.6/include/uapi/linux/stat.h
+const (
+	fileTypeMask    = 0170000
+	fileTypeSocket  = 0140000
+	fileTypeSymlink = 0120000
+	fileTypeRegular = 0100000
+	fileTypeBlock   = 0060000
+	fileTypeDir     = 0040000
+	fileTypeChar    = 0020000
+	fileTypeFIFO    = 0010000
+)
+
+// resolve follows the packaged symbolic links in the directory part of the
+// absolute path p. The last element is kept, so that a symbolic link is owned by
+// the package shipping it rather than by the owner of its target.
+func (idx *fileIndex) resolve(p string) string {
+	p = path.Clean("/" + p)
+	dir, base := path.Split(p)
+
+	// same limit as Linux, ref. MAXSYMLINKS
+	const maxSymlinks = 40
+
+	resolved := "/"
+	rest := strings.Split(dir, "/")
+	for links := 0; len(rest) > 0; {
+		elem := rest[0]
+		rest = rest[1:]
+
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			resolved = path.Dir(resolved)
+			continue
+		}
+
+		next := path.Join(resolved, elem)
+		target, ok := idx.symlinks[next]
+		if !ok {
+			resolved = next
+			continue
+		}
+
+		if links++; links > maxSymlinks {
+			// a symlink loop, keep the path as it is
+			return p
+		}
+		if path.IsAbs(target) {
+			resolved = "/"
+		}
+		rest = append(strings.Split(target, "/"), rest...)
+	}
+	return path.Join(resolved, base)
+}
@@ -0,0 +1,107 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRpmDB_WhatOwns(t *testing.T) {
+	type owner struct {
+		name  string
+		path  string
+		ghost bool
+	}
+	tests := []struct {
+		name string
+		file string // Test input file
+		path string
+		want []owner
+	}{
+		{
+			name: "file",
+			file: "testdata/sle15-bci/Packages.db",
+			path: "/usr/bin/bash",
+			want: []owner{{name: "bash", path: "/usr/bin/bash"}},
+		},
+		{
+			name: "directory owned by multiple packages",
+			file: "testdata/sle15-bci/Packages.db",
+			path: "/usr/lib/rpm/suse/",
+			want: []owner{
+				{name: "rpm-config-SUSE", path: "/usr/lib/rpm/suse"},
+				{name: "rpm-ndb", path: "/usr/lib/rpm/suse"},
+			},
+		},
+		{
+			name: "ghost",
+			file: "testdata/sle15-bci/Packages.db",
+			path: "/var/run",
+			want: []owner{{name: "filesystem", path: "/var/run", ghost: true}},
+		},
+		{
+			name: "unowned",
+			file: "testdata/sle15-bci/Packages.db",
+			path: "/usr/bin/perl5",
+		},
+		{
+			name: "symlinked directory prefix",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			path: "/usr/lib64/libc.so.6",
+			want: []owner{{name: "glibc", path: "/lib64/libc.so.6"}},
+		},
+		{
+			name: "symlink itself",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			path: "/lib64",
+			want: []owner{{name: "filesystem", path: "/lib64"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			owners, err := db.WhatOwns(tt.path)
+			require.NoError(t, err)
+
+			var got []owner
+			for _, o := range owners {
+				got = append(got, owner{name: o.Package.Name, path: o.File.Path, ghost: o.IsGhost()})
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_fileIndex_resolve(t *testing.T) {
+	idx := &fileIndex{symlinks: map[string]string{
+		"/lib":        "usr/lib",
+		"/lib64":      "lib",
+		"/usr/lib64":  "/usr/lib",
+		"/var/run":    "../run",
+		"/loop":       "/loop2",
+		"/loop2":      "loop",
+		"/usr/bin/sh": "bash",
+	}}
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/lib/libc.so.6", want: "/usr/lib/libc.so.6"},
+		{path: "/lib64/libc.so.6", want: "/usr/lib/libc.so.6"},
+		{path: "/usr/lib64/../lib64/libc.so.6", want: "/usr/lib/libc.so.6"},
+		{path: "/var/run/utmp", want: "/run/utmp"},
+		{path: "/lib64", want: "/lib64"},
+		{path: "/usr/bin/sh", want: "/usr/bin/sh"},
+		{path: "usr/bin//bash", want: "/usr/bin/bash"},
+		{path: "/loop/foo", want: "/loop/foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, idx.resolve(tt.path))
+		})
+	}
+}
@@ -20,7 +20,7 @@ type PackageInfo struct {
 	Release         string
 	Arch            string
 	SourceRpm       string
-	Size            int
+	Size            int64
 	License         string
 	Vendor          string
 	Modularitylabel string
@@ -30,38 +30,146 @@ type PackageInfo struct {
 	RSAHeader       string
 	DigestAlgorithm DigestAlgorithm
 	InstallTime     int
+	DBInstance      uint32
 	BaseNames       []string
 	DirIndexes      []int32
 	DirNames        []string
 	FileSizes       []int32
+	LongFileSizes   []int64
 	FileDigests     []string
 	FileModes       []uint16
 	FileFlags       []int32
 	UserNames       []string
 	GroupNames      []string
-
-	Provides []string
-	Requires []string
+	FileLinkTos     []string
+	FileMTimes      []uint32
+	FileRDevs       []uint16
+	FileStates      []int8
+	FileVerifyFlags []int32
+	FileCaps        []string
+	FileInodes      []uint32
+	FileDevices     []int32
+	FileLangs       []string
+	FileColors      []int32
+	FileClass       []int32
+	ClassDict       []string
+	FileDependsX    []int32
+	FileDependsN    []int32
+	DependsDict     []int32
+
+	ChangelogTimes []int32
+	ChangelogNames []string
+	ChangelogTexts []string
+
+	FileSignatures      []string
+	VeritySignatures    []string
+	VeritySignatureAlgo VerityHashAlgo
+
+	Provides        []string
+	ProvideFlags    []int32
+	ProvideVersions []string
+	Requires        []string
+	RequireFlags    []int32
+	RequireVersions []string
+
+	Conflicts          []string
+	ConflictFlags      []int32
+	ConflictVersions   []string
+	Obsoletes          []string
+	ObsoleteFlags      []int32
+	ObsoleteVersions   []string
+	Recommends         []string
+	RecommendFlags     []int32
+	RecommendVersions  []string
+	Suggests           []string
+	SuggestFlags       []int32
+	SuggestVersions    []string
+	Supplements        []string
+	SupplementFlags    []int32
+	SupplementVersions []string
+	Enhances           []string
+	EnhanceFlags       []int32
+	EnhanceVersions    []string
 }
 
 type FileInfo struct {
 	Path      string
 	Mode      uint16
 	Digest    string
-	Size      int32
+	Size      int64
 	Username  string
 	Groupname string
 	Flags     FileFlags
+
+	MTime       uint32 // seconds since the epoch
+	LinkTo      string // target of a symbolic link
+	Inode       uint32 // hard links share the inode and device
+	Device      int32
+	RDev        uint16 // device number of a device file
+	Lang        string
+	Caps        string // e.g. "cap_net_raw=ep"
+	Color       int32  // RPMFC_ELF32 or RPMFC_ELF64 for ELF files, used for multilib
+	Class       string // file(1) description, e.g. "ELF 64-bit LSB executable, x86-64"
+	VerifyFlags VerifyAttrs
+	Provides    []Dependency // generated from the file, like rpm -q --fileprovide
+	Requires    []Dependency // generated from the file, like rpm -q --filerequire
+
+	IMASignature    *IMASignature    // nil if the file is not signed
+	VeritySignature *VeritySignature // nil if the file is not signed
+	// IMASignatureErr and VeritySignatureErr report a signature that could not be
+	// decoded, leaving IMASignature or VeritySignature nil. The raw entries are in
+	// PackageInfo.FileSignatures and PackageInfo.VeritySignatures.
+	IMASignatureErr    error
+	VeritySignatureErr error
+}
+
+// invalidTypeError reports a tag that is not stored with the type rpm uses for it.
+func invalidTypeError(ie indexEntry) error {
+	return xerrors.Errorf("invalid type %s for tag %s", typeName(ie.Info.Type), TagName(ie.Info.Tag))
 }
 
 // ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.3-release/lib/tagexts.c#L752
 func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 	pkgInfo := &PackageInfo{}
+	hasLongSize := false
+	depSets := map[int32]dependencySet{
+		RPMTAG_CONFLICTNAME:    {&pkgInfo.Conflicts, &pkgInfo.ConflictFlags, &pkgInfo.ConflictVersions},
+		RPMTAG_OBSOLETENAME:    {&pkgInfo.Obsoletes, &pkgInfo.ObsoleteFlags, &pkgInfo.ObsoleteVersions},
+		RPMTAG_RECOMMENDNAME:   {&pkgInfo.Recommends, &pkgInfo.RecommendFlags, &pkgInfo.RecommendVersions},
+		RPMTAG_SUGGESTNAME:     {&pkgInfo.Suggests, &pkgInfo.SuggestFlags, &pkgInfo.SuggestVersions},
+		RPMTAG_SUPPLEMENTNAME:  {&pkgInfo.Supplements, &pkgInfo.SupplementFlags, &pkgInfo.SupplementVersions},
+		RPMTAG_ENHANCENAME:     {&pkgInfo.Enhances, &pkgInfo.EnhanceFlags, &pkgInfo.EnhanceVersions},
+		RPMTAG_OLDSUGGESTSNAME: {new([]string), new([]int32), new([]string)},
+		RPMTAG_OLDENHANCESNAME: {new([]string), new([]int32), new([]string)},
+	}
+
 	for _, ie := range indexEntries {
 		switch ie.Info.Tag {
+		case RPMTAG_CONFLICTNAME, RPMTAG_OBSOLETENAME, RPMTAG_RECOMMENDNAME, RPMTAG_SUGGESTNAME,
+			RPMTAG_SUPPLEMENTNAME, RPMTAG_ENHANCENAME, RPMTAG_OLDSUGGESTSNAME, RPMTAG_OLDENHANCESNAME:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			*depSets[ie.Info.Tag].names = parseStringArray(ie.Data)
+		case RPMTAG_CONFLICTFLAGS, RPMTAG_OBSOLETEFLAGS, RPMTAG_RECOMMENDFLAGS, RPMTAG_SUGGESTFLAGS,
+			RPMTAG_SUPPLEMENTFLAGS, RPMTAG_ENHANCEFLAGS, RPMTAG_OLDSUGGESTSFLAGS, RPMTAG_OLDENHANCESFLAGS:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			flags, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse %s: %w", TagName(ie.Info.Tag), err)
+			}
+			*depSets[dependencyNameTags[ie.Info.Tag]].flags = flags
+		case RPMTAG_CONFLICTVERSION, RPMTAG_OBSOLETEVERSION, RPMTAG_RECOMMENDVERSION, RPMTAG_SUGGESTVERSION,
+			RPMTAG_SUPPLEMENTVERSION, RPMTAG_ENHANCEVERSION, RPMTAG_OLDSUGGESTSVERSION, RPMTAG_OLDENHANCESVERSION:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			*depSets[dependencyNameTags[ie.Info.Tag]].versions = parseStringArrayN(ie.Data, int(ie.Info.Count))
 		case RPMTAG_DIRINDEXES:
 			if ie.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag dir indexes")
+				return nil, invalidTypeError(ie)
 			}
 
 			dirIndexes, err := parseInt32Array(ie.Data, ie.Length)
@@ -71,27 +179,27 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 			pkgInfo.DirIndexes = dirIndexes
 		case RPMTAG_DIRNAMES:
 			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag dir names")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.DirNames = parseStringArray(ie.Data)
 		case RPMTAG_BASENAMES:
 			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag base names")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.BaseNames = parseStringArray(ie.Data)
 		case RPMTAG_MODULARITYLABEL:
 			if ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag modularitylabel")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.Modularitylabel = string(bytes.TrimRight(ie.Data, "\x00"))
 		case RPMTAG_NAME:
 			if ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag name")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.Name = string(bytes.TrimRight(ie.Data, "\x00"))
 		case RPMTAG_EPOCH:
 			if ie.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag epoch")
+				return nil, invalidTypeError(ie)
 			}
 
 			if ie.Data != nil {
@@ -103,22 +211,22 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 			}
 		case RPMTAG_VERSION:
 			if ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag version")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.Version = string(bytes.TrimRight(ie.Data, "\x00"))
 		case RPMTAG_RELEASE:
 			if ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag release")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.Release = string(bytes.TrimRight(ie.Data, "\x00"))
 		case RPMTAG_ARCH:
 			if ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag arch")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.Arch = string(bytes.TrimRight(ie.Data, "\x00"))
 		case RPMTAG_SOURCERPM:
 			if ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag sourcerpm")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.SourceRpm = string(bytes.TrimRight(ie.Data, "\x00"))
 			if pkgInfo.SourceRpm == "(none)" {
@@ -126,17 +234,45 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 			}
 		case RPMTAG_PROVIDENAME:
 			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag providename")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.Provides = parseStringArray(ie.Data)
+		case RPMTAG_PROVIDEFLAGS:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			provideFlags, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse provide flags: %w", err)
+			}
+			pkgInfo.ProvideFlags = provideFlags
+		case RPMTAG_PROVIDEVERSION:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			pkgInfo.ProvideVersions = parseStringArrayN(ie.Data, int(ie.Info.Count))
 		case RPMTAG_REQUIRENAME:
 			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag requirename")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.Requires = parseStringArray(ie.Data)
+		case RPMTAG_REQUIREFLAGS:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			requireFlags, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse require flags: %w", err)
+			}
+			pkgInfo.RequireFlags = requireFlags
+		case RPMTAG_REQUIREVERSION:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			pkgInfo.RequireVersions = parseStringArrayN(ie.Data, int(ie.Info.Count))
 		case RPMTAG_LICENSE:
 			if ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag license")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.License = string(bytes.TrimRight(ie.Data, "\x00"))
 			if pkgInfo.License == "(none)" {
@@ -144,7 +280,7 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 			}
 		case RPMTAG_VENDOR:
 			if ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag vendor")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.Vendor = string(bytes.TrimRight(ie.Data, "\x00"))
 			if pkgInfo.Vendor == "(none)" {
@@ -152,19 +288,35 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 			}
 		case RPMTAG_SIZE:
 			if ie.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag size")
+				return nil, invalidTypeError(ie)
 			}
 
 			size, err := parseInt32(ie.Data)
 			if err != nil {
 				return nil, xerrors.Errorf("failed to parse size: %w", err)
 			}
-			pkgInfo.Size = size
+			// rpm_loff_t is unsigned, RPMTAG_LONGSIZE takes over beyond 4 GiB
+			if !hasLongSize {
+				pkgInfo.Size = int64(uint32(size))
+			}
+		case RPMTAG_LONGSIZE:
+			if ie.Info.Type != RPM_INT64_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			size, err := parseInt64Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse long size: %w", err)
+			}
+			if len(size) != 1 {
+				return nil, xerrors.Errorf("invalid long size count: %d", len(size))
+			}
+			pkgInfo.Size = size[0]
+			hasLongSize = true
 		case RPMTAG_FILEDIGESTALGO:
 			// note: all digests within a package entry only supports a single digest algorithm (there may be future support for
 			// algorithm noted for each file entry, but currently unimplemented: https://github.com/rpm-software-management/rpm/blob/0b75075a8d006c8f792d33a57eae7da6b66a4591/lib/rpmtag.h#L256)
 			if ie.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag digest algo")
+				return nil, invalidTypeError(ie)
 			}
 
 			digestAlgorithm, err := parseInt32(ie.Data)
@@ -176,22 +328,31 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 		case RPMTAG_FILESIZES:
 			// note: there is no distinction between int32, uint32, and []uint32
 			if ie.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag file-sizes")
+				return nil, invalidTypeError(ie)
 			}
 			fileSizes, err := parseInt32Array(ie.Data, ie.Length)
 			if err != nil {
 				return nil, xerrors.Errorf("failed to parse file-sizes: %w", err)
 			}
 			pkgInfo.FileSizes = fileSizes
+		case RPMTAG_LONGFILESIZES:
+			if ie.Info.Type != RPM_INT64_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			longFileSizes, err := parseInt64Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse long file-sizes: %w", err)
+			}
+			pkgInfo.LongFileSizes = longFileSizes
 		case RPMTAG_FILEDIGESTS:
 			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag file-digests")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.FileDigests = parseStringArray(ie.Data)
 		case RPMTAG_FILEMODES:
 			// note: there is no distinction between int16, uint16, and []uint16
 			if ie.Info.Type != RPM_INT16_TYPE {
-				return nil, xerrors.New("invalid tag file-modes")
+				return nil, invalidTypeError(ie)
 			}
 			fileModes, err := uint16Array(ie.Data, ie.Length)
 			if err != nil {
@@ -201,33 +362,195 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 		case RPMTAG_FILEFLAGS:
 			// note: there is no distinction between int32, uint32, and []uint32
 			if ie.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag file-flags")
+				return nil, invalidTypeError(ie)
 			}
 			fileFlags, err := parseInt32Array(ie.Data, ie.Length)
 			if err != nil {
 				return nil, xerrors.Errorf("failed to parse file-flags: %w", err)
 			}
 			pkgInfo.FileFlags = fileFlags
+		case RPMTAG_FILELINKTOS:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			// most entries are empty, so keep them to stay aligned with the other file tags
+			pkgInfo.FileLinkTos = parseStringArrayN(ie.Data, int(ie.Info.Count))
+		case RPMTAG_FILEMTIMES:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileMTimes, err := uint32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-mtimes: %w", err)
+			}
+			pkgInfo.FileMTimes = fileMTimes
+		case RPMTAG_FILERDEVS:
+			if ie.Info.Type != RPM_INT16_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileRDevs, err := uint16Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-rdevs: %w", err)
+			}
+			pkgInfo.FileRDevs = fileRDevs
+		case RPMTAG_FILESTATES:
+			if ie.Info.Type != RPM_CHAR_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileStates := make([]int8, len(ie.Data))
+			for i, state := range ie.Data {
+				fileStates[i] = int8(state)
+			}
+			pkgInfo.FileStates = fileStates
+		case RPMTAG_FILEVERIFYFLAGS:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileVerifyFlags, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-verify-flags: %w", err)
+			}
+			pkgInfo.FileVerifyFlags = fileVerifyFlags
+		case RPMTAG_FILECAPS:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			// files without capabilities have empty entries
+			pkgInfo.FileCaps = parseStringArrayN(ie.Data, int(ie.Info.Count))
+		case RPMTAG_FILEINODES:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileInodes, err := uint32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-inodes: %w", err)
+			}
+			pkgInfo.FileInodes = fileInodes
+		case RPMTAG_FILEDEVICES:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileDevices, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-devices: %w", err)
+			}
+			pkgInfo.FileDevices = fileDevices
+		case RPMTAG_FILELANGS:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			// files without a language have empty entries
+			pkgInfo.FileLangs = parseStringArrayN(ie.Data, int(ie.Info.Count))
+		case RPMTAG_FILECOLORS:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileColors, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-colors: %w", err)
+			}
+			pkgInfo.FileColors = fileColors
+		case RPMTAG_FILECLASS:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileClass, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-class: %w", err)
+			}
+			pkgInfo.FileClass = fileClass
+		case RPMTAG_CLASSDICT:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			// the class of some files is empty
+			pkgInfo.ClassDict = parseStringArrayN(ie.Data, int(ie.Info.Count))
+		case RPMTAG_FILEDEPENDSX:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileDependsX, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-depends-x: %w", err)
+			}
+			pkgInfo.FileDependsX = fileDependsX
+		case RPMTAG_FILEDEPENDSN:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			fileDependsN, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse file-depends-n: %w", err)
+			}
+			pkgInfo.FileDependsN = fileDependsN
+		case RPMTAG_DEPENDSDICT:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			dependsDict, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse depends-dict: %w", err)
+			}
+			pkgInfo.DependsDict = dependsDict
+		case RPMTAG_CHANGELOGTIME:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			changelogTimes, err := parseInt32Array(ie.Data, ie.Length)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse changelog-times: %w", err)
+			}
+			pkgInfo.ChangelogTimes = changelogTimes
+		case RPMTAG_CHANGELOGNAME:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			pkgInfo.ChangelogNames = parseStringArrayN(ie.Data, int(ie.Info.Count))
+		case RPMTAG_CHANGELOGTEXT:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			pkgInfo.ChangelogTexts = parseStringArrayN(ie.Data, int(ie.Info.Count))
+		case RPMTAG_FILESIGNATURES:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			// unsigned files have empty entries
+			pkgInfo.FileSignatures = parseStringArrayN(ie.Data, int(ie.Info.Count))
+		case RPMTAG_VERITYSIGNATURES:
+			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			pkgInfo.VeritySignatures = parseStringArrayN(ie.Data, int(ie.Info.Count))
+		case RPMTAG_VERITYSIGNATUREALGO:
+			if ie.Info.Type != RPM_INT32_TYPE {
+				return nil, invalidTypeError(ie)
+			}
+			algo, err := parseInt32(ie.Data)
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse verity signature algo: %w", err)
+			}
+			pkgInfo.VeritySignatureAlgo = VerityHashAlgo(algo)
 		case RPMTAG_FILEUSERNAME:
 			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag usernames")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.UserNames = parseStringArray(ie.Data)
 		case RPMTAG_FILEGROUPNAME:
 			if ie.Info.Type != RPM_STRING_ARRAY_TYPE {
-				return nil, xerrors.New("invalid tag groupnames")
+				return nil, invalidTypeError(ie)
 			}
 			pkgInfo.GroupNames = parseStringArray(ie.Data)
 		case RPMTAG_SUMMARY:
 			// some libraries have a string value instead of international string, so accounting for both
 			if ie.Info.Type != RPM_I18NSTRING_TYPE && ie.Info.Type != RPM_STRING_TYPE {
-				return nil, xerrors.New("invalid tag summary")
+				return nil, invalidTypeError(ie)
 			}
 			// since this is an international string, getting the first null terminated string
 			pkgInfo.Summary = string(bytes.Split(ie.Data, []byte{0})[0])
 		case RPMTAG_INSTALLTIME:
 			if ie.Info.Type != RPM_INT32_TYPE {
-				return nil, xerrors.New("invalid tag installtime")
+				return nil, invalidTypeError(ie)
 			}
 			installTime, err := parseInt32(ie.Data)
 			if err != nil {
@@ -240,7 +563,7 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 			pkgInfo.SigMD5 = hex.EncodeToString(digest)
 		case RPMTAG_RSAHEADER:
 			if ie.Info.Type != RPM_BIN_TYPE {
-				return nil, xerrors.New("invalid rsa signature")
+				return nil, invalidTypeError(ie)
 			}
 			val, err := parsePGP(ie)
 			if err != nil {
@@ -249,7 +572,7 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 			pkgInfo.RSAHeader = val
 		case RPMTAG_PGP:
 			if ie.Info.Type != RPM_BIN_TYPE {
-				return nil, xerrors.New("invalid pgp signature")
+				return nil, invalidTypeError(ie)
 			}
 			val, err := parsePGP(ie)
 			if err != nil {
@@ -259,6 +582,8 @@ func getNEVRA(indexEntries []indexEntry) (*PackageInfo, error) {
 		}
 	}
 
+	pkgInfo.convertOldWeakDependencies(depSets[RPMTAG_OLDSUGGESTSNAME], depSets[RPMTAG_OLDENHANCESNAME])
+
 	return pkgInfo, nil
 }
 
@@ -395,6 +720,18 @@ func parseInt32(data []byte) (int, error) {
 	return int(value), nil
 }
 
+// uint32Array parses an RPM_INT32_TYPE array of unsigned values, such as times
+// past 2038.
+func uint32Array(data []byte, arraySize int) ([]uint32, error) {
+	length := arraySize / sizeOfInt32
+	values := make([]uint32, length)
+	reader := bytes.NewReader(data)
+	if err := binary.Read(reader, binary.BigEndian, &values); err != nil {
+		return nil, xerrors.Errorf("failed to read binary: %w", err)
+	}
+	return values, nil
+}
+
 func uint16Array(data []byte, arraySize int) ([]uint16, error) {
 	length := arraySize / sizeOfUInt16
 	values := make([]uint16, length)
@@ -409,6 +746,13 @@ func parseStringArray(data []byte) []string {
 	return strings.Split(string(bytes.TrimRight(data, "\x00")), "\x00")
 }
 
+// parseStringArrayN is like parseStringArray, but keeps trailing empty strings
+// such as the versions of unversioned dependencies.
+func parseStringArrayN(data []byte, count int) []string {
+	values := strings.SplitN(string(data), "\x00", count+1)
+	return values[:min(count, len(values))]
+}
+
 func (p *PackageInfo) InstalledFileNames() ([]string, error) {
 	if p == nil || len(p.DirNames) == 0 || len(p.DirIndexes) == 0 || len(p.BaseNames) == 0 {
 		return nil, nil
@@ -437,11 +781,23 @@ func (p *PackageInfo) InstalledFiles() ([]FileInfo, error) {
 		return nil, err
 	}
 
+	// the file dependencies point into the provides and requires of the package
+	var provides, requires []Dependency
+	if len(p.DependsDict) > 0 {
+		if provides, err = p.ProvideDependencies(); err != nil {
+			return nil, err
+		}
+		if requires, err = p.RequireDependencies(); err != nil {
+			return nil, err
+		}
+	}
+
 	var files []FileInfo
 	for i, fileName := range fileNames {
 		var digest, username, groupname string
 		var mode uint16
-		var size, flags int32
+		var size int64
+		var flags int32
 
 		if p.FileDigests != nil && len(p.FileDigests) > i {
 			digest = p.FileDigests[i]
@@ -451,8 +807,11 @@ func (p *PackageInfo) InstalledFiles() ([]FileInfo, error) {
 			mode = p.FileModes[i]
 		}
 
-		if p.FileSizes != nil && len(p.FileSizes) > i {
-			size = p.FileSizes[i]
+		// rpm writes RPMTAG_LONGFILESIZES instead if a file is over 4 GiB
+		if len(p.LongFileSizes) > i {
+			size = p.LongFileSizes[i]
+		} else if p.FileSizes != nil && len(p.FileSizes) > i {
+			size = int64(uint32(p.FileSizes[i]))
 		}
 
 		if p.UserNames != nil && len(p.UserNames) > i {
@@ -476,12 +835,87 @@ func (p *PackageInfo) InstalledFiles() ([]FileInfo, error) {
 			Groupname: groupname,
 			Flags:     FileFlags(flags),
 		}
+
+		if len(p.FileMTimes) > i {
+			record.MTime = p.FileMTimes[i]
+		}
+		if len(p.FileLinkTos) > i {
+			record.LinkTo = p.FileLinkTos[i]
+		}
+		if len(p.FileInodes) > i {
+			record.Inode = p.FileInodes[i]
+		}
+		if len(p.FileDevices) > i {
+			record.Device = p.FileDevices[i]
+		}
+		if len(p.FileRDevs) > i {
+			record.RDev = p.FileRDevs[i]
+		}
+		if len(p.FileLangs) > i {
+			record.Lang = p.FileLangs[i]
+		}
+		if len(p.FileCaps) > i {
+			record.Caps = p.FileCaps[i]
+		}
+		if len(p.FileColors) > i {
+			record.Color = p.FileColors[i]
+		}
+		if len(p.FileClass) > i {
+			if class := int(p.FileClass[i]); class >= 0 && class < len(p.ClassDict) {
+				record.Class = p.ClassDict[class]
+			}
+		}
+
+		// rpm verifies everything if the header has no RPMTAG_FILEVERIFYFLAGS
+		record.VerifyFlags = RPMVERIFY_ALL
+		if len(p.FileVerifyFlags) > i {
+			record.VerifyFlags = VerifyAttrs(p.FileVerifyFlags[i])
+		}
+
+		record.Provides, record.Requires = p.fileDependencies(i, provides, requires)
+
+		// a signature rpm's plugins would not understand does not hide the file
+		if len(p.FileSignatures) > i {
+			record.IMASignature, record.IMASignatureErr = parseIMASignature(p.FileSignatures[i])
+		}
+		if len(p.VeritySignatures) > i {
+			record.VeritySignature, record.VeritySignatureErr = parseVeritySignature(p.VeritySignatures[i], p.VeritySignatureAlgo)
+		}
 		files = append(files, record)
 	}
 
 	return files, nil
 }
 
+// fileDependencies returns the provides and requires of the i-th file. Its
+// RPMTAG_DEPENDSDICT entries hold the dependency type in the top byte and the
+// index into the dependencies of that type in the rest. Like rpm, entries of
+// another type or out of range are skipped, and so are the dependencies of a
+// file pointing outside the dictionary.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/rpmfiles.c#L470-L490
+// ref. filedepTag() in https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/tagexts.c
+func (p *PackageInfo) fileDependencies(i int, provides, requires []Dependency) ([]Dependency, []Dependency) {
+	if len(p.FileDependsN) <= i || len(p.FileDependsX) <= i || p.FileDependsN[i] <= 0 {
+		return nil, nil
+	}
+	start, n := int(p.FileDependsX[i]), int(p.FileDependsN[i])
+	if start < 0 || start+n > len(p.DependsDict) {
+		return nil, nil
+	}
+
+	var fileProvides, fileRequires []Dependency
+	for _, dep := range p.DependsDict[start : start+n] {
+		depType, index := byte(uint32(dep)>>24), int(dep&0x00ffffff)
+		switch {
+		case depType == 'P' && index < len(provides):
+			fileProvides = append(fileProvides, provides[index])
+		case depType == 'R' && index < len(requires):
+			fileRequires = append(fileRequires, requires[index])
+		}
+	}
+	return fileProvides, fileRequires
+}
+
 func (p *PackageInfo) EpochNum() int {
 	if p.Epoch == nil {
 		return 0
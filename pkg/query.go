@@ -0,0 +1,254 @@
+package rpmdb
+
+import (
+	"context"
+	"slices"
+
+	"golang.org/x/xerrors"
+)
+
+// WhatProvides returns the packages providing a capability or file, like
+// rpm -q --whatprovides. The capability may carry a version range, e.g.
+// "libssl.so.3()(64bit)" or "bash >= 5.0".
+func (d *RpmDB) WhatProvides(capability string) ([]*PackageInfo, error) {
+	return d.WhatProvidesContext(context.Background(), capability)
+}
+
+// WhatProvidesContext is like WhatProvides, but gives up once ctx is done.
+func (d *RpmDB) WhatProvidesContext(ctx context.Context, capability string) ([]*PackageInfo, error) {
+	return d.query(ctx, capability, func(idx *packageIndex) dependencyIndex { return idx.provides })
+}
+
+// WhatRequires returns the packages requiring a capability, like
+// rpm -q --whatrequires. Rich requirements match if any of their simple
+// dependencies does.
+func (d *RpmDB) WhatRequires(capability string) ([]*PackageInfo, error) {
+	return d.WhatRequiresContext(context.Background(), capability)
+}
+
+// WhatRequiresContext is like WhatRequires, but gives up once ctx is done.
+func (d *RpmDB) WhatRequiresContext(ctx context.Context, capability string) ([]*PackageInfo, error) {
+	return d.query(ctx, capability, func(idx *packageIndex) dependencyIndex { return idx.requires })
+}
+
+// WhatRecommends returns the packages recommending a capability, like
+// rpm -q --whatrecommends.
+func (d *RpmDB) WhatRecommends(capability string) ([]*PackageInfo, error) {
+	return d.WhatRecommendsContext(context.Background(), capability)
+}
+
+// WhatRecommendsContext is like WhatRecommends, but gives up once ctx is done.
+func (d *RpmDB) WhatRecommendsContext(ctx context.Context, capability string) ([]*PackageInfo, error) {
+	return d.query(ctx, capability, func(idx *packageIndex) dependencyIndex { return idx.recommends })
+}
+
+// WhatConflicts returns the packages conflicting with a capability, like
+// rpm -q --whatconflicts.
+func (d *RpmDB) WhatConflicts(capability string) ([]*PackageInfo, error) {
+	return d.WhatConflictsContext(context.Background(), capability)
+}
+
+// WhatConflictsContext is like WhatConflicts, but gives up once ctx is done.
+func (d *RpmDB) WhatConflictsContext(ctx context.Context, capability string) ([]*PackageInfo, error) {
+	return d.query(ctx, capability, func(idx *packageIndex) dependencyIndex { return idx.conflicts })
+}
+
+func (d *RpmDB) query(ctx context.Context, capability string, index func(*packageIndex) dependencyIndex) ([]*PackageInfo, error) {
+	dep, err := parseDependency(capability)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := d.packageIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return index(idx).lookup(dep), nil
+}
+
+// RequiredBy returns the requirements of other installed packages that would no
+// longer be satisfied if every package called name were erased, like
+// rpm -e --test. Requirements which are already broken are not reported.
+func (d *RpmDB) RequiredBy(name string) ([]DependencyProblem, error) {
+	return d.RequiredByContext(context.Background(), name)
+}
+
+// RequiredByContext is like RequiredBy, but gives up once ctx is done.
+func (d *RpmDB) RequiredByContext(ctx context.Context, name string) ([]DependencyProblem, error) {
+	idx, err := d.packageIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var erased []*PackageInfo
+	for _, pkg := range idx.packages {
+		if pkg.Name == name {
+			erased = append(erased, pkg)
+		}
+	}
+	if len(erased) == 0 {
+		return nil, xerrors.Errorf("%s is not installed", name)
+	}
+	return requiredBy(idx, erased)
+}
+
+func requiredBy(idx *packageIndex, erased []*PackageInfo) ([]DependencyProblem, error) {
+	var problems []DependencyProblem
+
+	// a requirement listed more than once is reported once, as CheckDependencies does
+	// ref. appendProblem() in https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/rpmte.c
+	type problemKey struct {
+		pkg     *PackageInfo
+		problem string
+	}
+	seen := make(map[problemKey]struct{})
+
+	for _, pkg := range idx.packages {
+		if slices.Contains(erased, pkg) {
+			continue
+		}
+		requires, err := pkg.RequireDependencies()
+		if err != nil {
+			return nil, err
+		}
+		for _, req := range requires {
+			if ignoredRequirement(req) {
+				continue
+			}
+			before := req.satisfied(func(dep Dependency) bool { return len(idx.whatProvides(dep)) > 0 })
+			after := req.satisfied(func(dep Dependency) bool { return len(idx.whatProvides(dep, erased...)) > 0 })
+			if !before || after {
+				continue
+			}
+			problem := DependencyProblem{
+				Kind:       DependencyUnsatisfied,
+				Package:    pkg,
+				Dependency: req,
+			}
+			key := problemKey{pkg: pkg, problem: problem.String()}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			problems = append(problems, problem)
+		}
+	}
+	return problems, nil
+}
+
+// packageIndex returns the index of all installed packages, reading the
+// database on first use.
+func (d *RpmDB) packageIndex(ctx context.Context) (*packageIndex, error) {
+	d.indexMu.Lock()
+	defer d.indexMu.Unlock()
+
+	if d.index != nil {
+		return d.index, nil
+	}
+	pkgList, err := d.ListPackagesContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := newPackageIndex(pkgList)
+	if err != nil {
+		return nil, err
+	}
+	d.index = idx
+	return idx, nil
+}
+
+// packageIndex maps dependency names to the installed packages declaring them.
+type packageIndex struct {
+	packages   []*PackageInfo
+	provides   dependencyIndex // including the installed files
+	requires   dependencyIndex
+	recommends dependencyIndex
+	conflicts  dependencyIndex
+}
+
+func newPackageIndex(pkgList []*PackageInfo) (*packageIndex, error) {
+	idx := &packageIndex{
+		packages:   pkgList,
+		provides:   make(dependencyIndex),
+		requires:   make(dependencyIndex),
+		recommends: make(dependencyIndex),
+		conflicts:  make(dependencyIndex),
+	}
+	for _, pkg := range pkgList {
+		provides, err := pkg.ProvideDependencies()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get provides of %s: %w", pkg.Name, err)
+		}
+		idx.provides.add(pkg, provides...)
+
+		// every file is implicitly provided by its package
+		fileNames, err := pkg.InstalledFileNames()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get files of %s: %w", pkg.Name, err)
+		}
+		for _, fileName := range fileNames {
+			idx.provides.add(pkg, Dependency{Name: fileName})
+		}
+
+		requires, err := pkg.RequireDependencies()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get requires of %s: %w", pkg.Name, err)
+		}
+		idx.requires.add(pkg, requires...)
+
+		recommends, err := pkg.RecommendDependencies()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get recommends of %s: %w", pkg.Name, err)
+		}
+		idx.recommends.add(pkg, recommends...)
+
+		conflicts, err := pkg.ConflictDependencies()
+		if err != nil {
+			return nil, xerrors.Errorf("failed to get conflicts of %s: %w", pkg.Name, err)
+		}
+		idx.conflicts.add(pkg, conflicts...)
+	}
+	return idx, nil
+}
+
+// whatProvides returns the packages, other than those excluded, providing the
+// simple dependency dep.
+func (idx *packageIndex) whatProvides(dep Dependency, exclude ...*PackageInfo) []*PackageInfo {
+	return idx.provides.lookup(dep, exclude...)
+}
+
+// dependencyIndex maps the names of simple dependencies to the packages
+// declaring them. Rich dependencies are indexed under each of their simple
+// dependencies, like rpm does for its Requirename and similar indexes.
+type dependencyIndex map[string][]packageDependency
+
+type packageDependency struct {
+	pkg *PackageInfo
+	dep Dependency
+}
+
+func (idx dependencyIndex) add(pkg *PackageInfo, deps ...Dependency) {
+	for _, dep := range deps {
+		leaves := []Dependency{dep}
+		if dep.IsRich() {
+			// a malformed rich dependency is only found by its full name
+			if r, err := parseRichDependency(dep.Name); err == nil {
+				leaves = r.leaves()
+			}
+		}
+		for _, leaf := range leaves {
+			idx[leaf.Name] = append(idx[leaf.Name], packageDependency{pkg: pkg, dep: leaf})
+		}
+	}
+}
+
+// lookup returns the packages, other than those excluded, declaring a
+// dependency whose range overlaps with dep.
+func (idx dependencyIndex) lookup(dep Dependency, exclude ...*PackageInfo) []*PackageInfo {
+	var pkgs []*PackageInfo
+	for _, pd := range idx[dep.Name] {
+		if !slices.Contains(exclude, pd.pkg) && dep.overlaps(pd.dep) {
+			pkgs = append(pkgs, pd.pkg)
+		}
+	}
+	return uniquePackages(pkgs)
+}
@@ -0,0 +1,142 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRpmDB_WhatProvides(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      func(d *RpmDB, capability string) ([]*PackageInfo, error)
+		capability string
+		want       []string
+		wantErr    string
+	}{
+		{
+			name:       "provides a library",
+			query:      (*RpmDB).WhatProvides,
+			capability: "libc.so.6()(64bit)",
+			want:       []string{"glibc"},
+		},
+		{
+			name:       "provides a file",
+			query:      (*RpmDB).WhatProvides,
+			capability: "/bin/bash",
+			want:       []string{"bash"},
+		},
+		{
+			name:       "provides a version range",
+			query:      (*RpmDB).WhatProvides,
+			capability: "glibc >= 2.30",
+			want:       []string{"glibc"},
+		},
+		{
+			name:       "provides nothing in the version range",
+			query:      (*RpmDB).WhatProvides,
+			capability: "glibc < 2.0",
+		},
+		{
+			name:       "requires",
+			query:      (*RpmDB).WhatRequires,
+			capability: "/bin/sh",
+			want:       []string{"coreutils", "sles-release", "ca-certificates-mozilla-prebuilt", "rpm-config-SUSE", "rpm-ndb"},
+		},
+		{
+			name:       "recommends",
+			query:      (*RpmDB).WhatRecommends,
+			capability: "bash-doc",
+			want:       []string{"bash"},
+		},
+		{
+			name:       "conflicts",
+			query:      (*RpmDB).WhatConflicts,
+			capability: "rpm",
+			want:       []string{"rpm-ndb"},
+		},
+		{
+			name:       "invalid capability",
+			query:      (*RpmDB).WhatProvides,
+			capability: "(bash or zsh)",
+			wantErr:    "rich dependencies cannot be queried",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open("testdata/sle15-bci/Packages.db")
+			require.NoError(t, err)
+			defer db.Close()
+
+			pkgs, err := tt.query(db, tt.capability)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []string
+			for _, pkg := range pkgs {
+				got = append(got, pkg.Name)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRpmDB_RequiredBy(t *testing.T) {
+	db, err := Open("testdata/sle15-bci/Packages.db")
+	require.NoError(t, err)
+	defer db.Close()
+
+	problems, err := db.RequiredBy("libzstd1")
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Equal(t, "libzstd.so.1()(64bit) is needed by rpm-ndb-4.14.3-40.1.x86_64", problems[0].String())
+
+	// the index is reused by later queries
+	pkgs, err := db.WhatProvides("libzstd.so.1()(64bit)")
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+	assert.Equal(t, "libzstd1", pkgs[0].Name)
+
+	_, err = db.RequiredBy("zsh")
+	require.ErrorContains(t, err, "zsh is not installed")
+}
+
+func Test_requiredBy(t *testing.T) {
+	pkg := func(name string, requires ...string) *PackageInfo {
+		return &PackageInfo{
+			Name:     name,
+			Version:  "1.0",
+			Release:  "1",
+			Arch:     "noarch",
+			Provides: []string{name},
+			Requires: requires,
+		}
+	}
+	bash := pkg("bash")
+	zsh := pkg("zsh")
+	foo := pkg("foo", "(bash or zsh)", "bash", "bash", "missing")
+
+	idx, err := newPackageIndex([]*PackageInfo{bash, zsh, foo})
+	require.NoError(t, err)
+
+	problems, err := requiredBy(idx, []*PackageInfo{bash})
+	require.NoError(t, err)
+	assert.Equal(t, []DependencyProblem{
+		{
+			Kind:       DependencyUnsatisfied,
+			Package:    foo,
+			Dependency: Dependency{Name: "bash"},
+		},
+	}, problems)
+
+	problems, err = requiredBy(idx, []*PackageInfo{bash, zsh})
+	require.NoError(t, err)
+	assert.Len(t, problems, 2)
+	assert.Equal(t, "(bash or zsh)", problems[0].Dependency.Name)
+
+	assert.Equal(t, []*PackageInfo{foo}, idx.requires.lookup(Dependency{Name: "zsh"}))
+}
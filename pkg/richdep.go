@@ -0,0 +1,288 @@
+package rpmdb
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// richOp is an operator of a rich (boolean) dependency.
+// ref. https://rpm-software-management.github.io/rpm/manual/boolean_dependencies.html
+type richOp string
+
+const (
+	richOpSingle  richOp = "" // a simple dependency
+	richOpAnd     richOp = "and"
+	richOpOr      richOp = "or"
+	richOpIf      richOp = "if"
+	richOpUnless  richOp = "unless"
+	richOpWith    richOp = "with"
+	richOpWithout richOp = "without"
+)
+
+// richDependency is the parse tree of a rich dependency such as
+// "(foo >= 1.0 if (bar or baz))".
+type richDependency struct {
+	op       richOp
+	dep      Dependency        // for richOpSingle
+	operands []*richDependency // the chained operands of and, or and with, or the subject of the others
+	cond     *richDependency   // the condition of if, unless and without
+	els      *richDependency   // the optional else branch of if and unless
+}
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/rpmds.c
+var senseOperators = []struct {
+	op    string
+	flags DependencyFlags
+}{
+	{op: "=<", flags: RPMSENSE_LESS | RPMSENSE_EQUAL},
+	{op: "<=", flags: RPMSENSE_LESS | RPMSENSE_EQUAL},
+	{op: "=>", flags: RPMSENSE_GREATER | RPMSENSE_EQUAL},
+	{op: ">=", flags: RPMSENSE_GREATER | RPMSENSE_EQUAL},
+	{op: "==", flags: RPMSENSE_EQUAL},
+	{op: "<", flags: RPMSENSE_LESS},
+	{op: "=", flags: RPMSENSE_EQUAL},
+	{op: ">", flags: RPMSENSE_GREATER},
+}
+
+// IsRich reports whether d is a rich (boolean) dependency like "(a or b)".
+func (d Dependency) IsRich() bool {
+	return strings.HasPrefix(d.Name, "(")
+}
+
+// parseRichDependency parses a rich dependency the way rpmrichParse does.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/rpmds.c
+func parseRichDependency(s string) (*richDependency, error) {
+	p := &richParser{s: s}
+	r, err := p.parse()
+	if err != nil {
+		return nil, xerrors.Errorf("invalid rich dependency %q: %w", s, err)
+	}
+	if p.skipSpace(); p.pos != len(p.s) {
+		return nil, xerrors.Errorf("invalid rich dependency %q: trailing characters", s)
+	}
+	return r, nil
+}
+
+type richParser struct {
+	s   string
+	pos int
+}
+
+func (p *richParser) skipSpace() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// parse parses "(" operand [op operand ...] ")" at the current position.
+func (p *richParser) parse() (*richDependency, error) {
+	p.skipSpace()
+	if p.pos >= len(p.s) || p.s[p.pos] != '(' {
+		return nil, xerrors.New("missing '('")
+	}
+	p.pos++
+
+	first, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == ')' {
+		p.pos++
+		return first, nil
+	}
+
+	op := richOp(p.word())
+	switch op {
+	case richOpAnd, richOpOr, richOpWith:
+		r := &richDependency{op: op, operands: []*richDependency{first}}
+		for {
+			next, err := p.operand()
+			if err != nil {
+				return nil, err
+			}
+			r.operands = append(r.operands, next)
+			if p.skipSpace(); p.pos < len(p.s) && p.s[p.pos] == ')' {
+				p.pos++
+				return r, nil
+			}
+			if w := richOp(p.word()); w != op {
+				return nil, xerrors.Errorf("cannot chain %q with %q", op, w)
+			}
+		}
+	case richOpIf, richOpUnless, richOpWithout:
+		r := &richDependency{op: op, operands: []*richDependency{first}}
+		if r.cond, err = p.operand(); err != nil {
+			return nil, err
+		}
+		if pos := p.pos; op != richOpWithout && p.word() == "else" {
+			if r.els, err = p.operand(); err != nil {
+				return nil, err
+			}
+		} else {
+			p.pos = pos
+		}
+		p.skipSpace()
+		if p.pos >= len(p.s) || p.s[p.pos] != ')' {
+			return nil, xerrors.New("missing ')'")
+		}
+		p.pos++
+		return r, nil
+	default:
+		return nil, xerrors.Errorf("unknown operator %q", op)
+	}
+}
+
+// operand parses either a nested rich dependency or "name [op evr]".
+func (p *richParser) operand() (*richDependency, error) {
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == '(' {
+		return p.parse()
+	}
+
+	// names like "libc.so.6()(64bit)" contain balanced parentheses
+	start, depth := p.pos, 0
+	for ; p.pos < len(p.s) && p.s[p.pos] != ' '; p.pos++ {
+		if p.s[p.pos] == '(' {
+			depth++
+		} else if p.s[p.pos] == ')' {
+			if depth == 0 {
+				break
+			}
+			depth--
+		}
+	}
+	if p.pos == start {
+		return nil, xerrors.New("missing dependency name")
+	}
+	dep := Dependency{Name: p.s[start:p.pos]}
+
+	p.skipSpace()
+	for _, so := range senseOperators {
+		if strings.HasPrefix(p.s[p.pos:], so.op) {
+			p.pos += len(so.op)
+			p.skipSpace()
+			if dep.EVR = p.word(); dep.EVR == "" {
+				return nil, xerrors.Errorf("missing version after %q", so.op)
+			}
+			dep.Flags = so.flags
+			break
+		}
+	}
+	return &richDependency{op: richOpSingle, dep: dep}, nil
+}
+
+// word reads up to the next space or closing parenthesis.
+func (p *richParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] != ' ' && p.s[p.pos] != ')' {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// satisfied evaluates the rich dependency, using match to look up its simple
+// dependencies. Since a match does not tell which package it comes from, "with"
+// and "without" are approximated by "and" and "and not".
+func (r *richDependency) satisfied(match func(Dependency) bool) bool {
+	switch r.op {
+	case richOpSingle:
+		return match(r.dep)
+	case richOpAnd, richOpWith:
+		for _, o := range r.operands {
+			if !o.satisfied(match) {
+				return false
+			}
+		}
+		return true
+	case richOpOr:
+		for _, o := range r.operands {
+			if o.satisfied(match) {
+				return true
+			}
+		}
+		return false
+	case richOpIf:
+		if r.cond.satisfied(match) {
+			return r.operands[0].satisfied(match)
+		}
+		return r.els == nil || r.els.satisfied(match)
+	case richOpUnless:
+		if !r.cond.satisfied(match) {
+			return r.operands[0].satisfied(match)
+		}
+		return r.els == nil || r.els.satisfied(match)
+	case richOpWithout:
+		return r.operands[0].satisfied(match) && !r.cond.satisfied(match)
+	}
+	return false
+}
+
+// required returns the simple dependencies the installed packages, looked up by
+// match, need to provide for the rich dependency: all the operands of "and" and
+// "with", the satisfied alternatives of "or", and the branch the condition of
+// "if" and "unless" selects. Conditions themselves are never required.
+func (r *richDependency) required(match func(Dependency) bool) []Dependency {
+	var deps []Dependency
+	switch r.op {
+	case richOpSingle:
+		deps = append(deps, r.dep)
+	case richOpAnd, richOpWith:
+		for _, o := range r.operands {
+			deps = append(deps, o.required(match)...)
+		}
+	case richOpOr:
+		for _, o := range r.operands {
+			if o.satisfied(match) {
+				deps = append(deps, o.required(match)...)
+			}
+		}
+	case richOpIf, richOpUnless:
+		if r.cond.satisfied(match) == (r.op == richOpIf) {
+			deps = append(deps, r.operands[0].required(match)...)
+		} else if r.els != nil {
+			deps = append(deps, r.els.required(match)...)
+		}
+	case richOpWithout:
+		deps = append(deps, r.operands[0].required(match)...)
+	}
+	return deps
+}
+
+// leaves returns the simple dependencies the rich dependency is made of.
+func (r *richDependency) leaves() []Dependency {
+	if r.op == richOpSingle {
+		return []Dependency{r.dep}
+	}
+	var deps []Dependency
+	for _, o := range r.operands {
+		deps = append(deps, o.leaves()...)
+	}
+	if r.cond != nil {
+		deps = append(deps, r.cond.leaves()...)
+	}
+	if r.els != nil {
+		deps = append(deps, r.els.leaves()...)
+	}
+	return deps
+}
+
+// parseDependency parses a simple dependency as given on the rpm command line,
+// e.g. "libssl.so.3()(64bit)" or "bash >= 5.0".
+func parseDependency(s string) (Dependency, error) {
+	p := &richParser{s: s}
+	r, err := p.operand()
+	if err != nil {
+		return Dependency{}, xerrors.Errorf("invalid dependency %q: %w", s, err)
+	}
+	if r.op != richOpSingle {
+		return Dependency{}, xerrors.Errorf("invalid dependency %q: rich dependencies cannot be queried", s)
+	}
+	if p.skipSpace(); p.pos != len(p.s) {
+		return Dependency{}, xerrors.Errorf("invalid dependency %q: trailing characters", s)
+	}
+	return r.dep, nil
+}
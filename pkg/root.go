@@ -0,0 +1,356 @@
+package rpmdb
+
+import (
+	"bufio"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+var ErrorRpmDBNotFound = xerrors.New("rpmdb not found")
+
+var (
+	// the locations used by distributions when %_dbpath is not configured
+	// ref. https://fedoraproject.org/wiki/Changes/RelocateRPMToUsr
+	defaultDBPaths = []string{
+		"/usr/lib/sysimage/rpm",
+		"/var/lib/rpm",
+		"/usr/share/rpm", // rpm-ostree
+	}
+
+	// rpm reads these in order, later definitions override earlier ones
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.18.0-release/lib/rpmrc.c
+	macroFiles = []string{
+		"usr/lib/rpm/macros",
+		"usr/lib/rpm/macros.d/macros.*",
+		"usr/lib/rpm/*/macros",
+		"etc/rpm/macros.*",
+		"etc/rpm/macros",
+	}
+
+	// default macros in case the root does not ship any macro files
+	defaultMacros = map[string]string{
+		"_prefix": "/usr",
+		"_usr":    "/usr",
+		"_var":    "/var",
+	}
+)
+
+// dbBackend is a database file rpm knows how to open, in the order rpm's backend
+// autodetection probes them.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.18.0-release/lib/backend/dbi.c
+type dbBackend struct {
+	name   string // value of %_db_backend
+	file   string
+	format Format
+}
+
+var dbBackends = []dbBackend{
+	{name: "sqlite", file: "rpmdb.sqlite", format: FormatSQLite},
+	{name: "ndb", file: "Packages.db", format: FormatNDB},
+	{name: "bdb", file: "Packages", format: FormatBerkeleyDB},
+	{name: "bdb_ro", file: "Packages", format: FormatBerkeleyDB},
+}
+
+// OpenRoot finds and opens the rpmdb of the root filesystem mounted at root, the
+// way rpm --root would. The configured %_dbpath is honoured, falling back to the
+// well-known locations. Path and Format report what was chosen.
+func OpenRoot(root string) (*RpmDB, error) {
+	name, err := findRpmDB(DirFS(root))
+	if err != nil {
+		return nil, err
+	}
+	return Open(filepath.Join(root, filepath.FromSlash(name)))
+}
+
+// OpenRootFS is like OpenRoot for a root filesystem given as fsys. Symbolic links
+// are only followed if fsys provides ReadLink and Lstat methods, like
+// io/fs.ReadLinkFS.
+func OpenRootFS(fsys fs.FS) (*RpmDB, error) {
+	name, err := findRpmDB(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return OpenFS(fsys, name)
+}
+
+// findRpmDB returns the name of the database file within fsys.
+func findRpmDB(fsys fs.FS) (string, error) {
+	macros, err := readMacros(fsys)
+	if err != nil {
+		return "", xerrors.Errorf("failed to read macros: %w", err)
+	}
+
+	dbPaths := defaultDBPaths
+	if dbPath, ok := expandMacro(macros, "_dbpath"); ok && path.IsAbs(dbPath) {
+		dbPaths = append([]string{dbPath}, defaultDBPaths...)
+	}
+	dbBackend, _ := expandMacro(macros, "_db_backend")
+
+	seen := make(map[string]struct{})
+	for _, dbPath := range dbPaths {
+		dir, err := resolvePath(fsys, dbPath)
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[dir]; ok {
+			continue
+		}
+		seen[dir] = struct{}{}
+
+		if name, ok := detectBackend(fsys, dir, strings.TrimSpace(dbBackend)); ok {
+			return name, nil
+		}
+	}
+	return "", ErrorRpmDBNotFound
+}
+
+// detectBackend picks the database file in dir that rpm would use: the configured
+// backend if its file exists, otherwise the first one found on disk.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.18.0-release/lib/backend/dbi.c
+func detectBackend(fsys fs.FS, dir, configured string) (string, bool) {
+	exists := func(b dbBackend) (string, bool) {
+		name, err := resolvePath(fsys, path.Join(dir, b.file))
+		if err != nil {
+			return "", false
+		}
+		fi, err := fs.Stat(fsys, name)
+		if err != nil || !fi.Mode().IsRegular() {
+			return "", false
+		}
+		return name, true
+	}
+
+	for _, b := range dbBackends {
+		if b.name == configured {
+			if name, ok := exists(b); ok {
+				return name, true
+			}
+		}
+	}
+	for _, b := range dbBackends {
+		if name, ok := exists(b); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// readMacros collects the simple (non-parametric) macro definitions from the
+// macro files of the root. Macro files are looked up like resolvePath does, so
+// that a symbolic link never leads to the macros of the host.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.18.0-release/rpmio/macro.c
+func readMacros(fsys fs.FS) (map[string]string, error) {
+	macros := make(map[string]string)
+	for k, v := range defaultMacros {
+		macros[k] = v
+	}
+
+	for _, pattern := range macroFiles {
+		names, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			continue
+		}
+		for _, name := range names {
+			resolved, err := resolvePath(fsys, name)
+			if err != nil {
+				continue
+			}
+			f, err := fsys.Open(resolved)
+			if err != nil {
+				continue
+			}
+			err = parseMacros(bufio.NewScanner(f), macros)
+			_ = f.Close()
+			if err != nil {
+				return nil, xerrors.Errorf("failed to parse %s: %w", name, err)
+			}
+		}
+	}
+	return macros, nil
+}
+
+func parseMacros(scanner *bufio.Scanner, macros map[string]string) error {
+	var line string
+	for scanner.Scan() {
+		// a trailing backslash continues the definition on the next line
+		text := scanner.Text()
+		if strings.HasSuffix(text, "\\") {
+			line += strings.TrimSuffix(text, "\\") + "\n"
+			continue
+		}
+		def := strings.TrimSpace(line + text)
+		line = ""
+
+		if !strings.HasPrefix(def, "%") {
+			continue
+		}
+		def = def[1:]
+		end := strings.IndexFunc(def, func(r rune) bool {
+			return !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+		})
+		if end <= 0 || def[end] == '(' {
+			// not a macro definition, or a parametric one
+			continue
+		}
+		macros[def[:end]] = strings.TrimSpace(def[end:])
+	}
+	return scanner.Err()
+}
+
+// expandMacro expands the named macro, supporting the %name, %{name} and
+// %{?name} forms. It reports false if an undefined macro is referenced.
+func expandMacro(macros map[string]string, name string) (string, bool) {
+	return expandMacroDepth(macros, name, 0)
+}
+
+func expandMacroDepth(macros map[string]string, name string, depth int) (string, bool) {
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.18.0-release/rpmio/macro.c
+	const maxMacroDepth = 64
+	if depth > maxMacroDepth {
+		return "", false
+	}
+
+	body, ok := macros[name]
+	if !ok {
+		return "", false
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(body); i++ {
+		if body[i] != '%' || i == len(body)-1 {
+			sb.WriteByte(body[i])
+			continue
+		}
+
+		i++
+		var ref string
+		optional := false
+		switch {
+		case body[i] == '%':
+			sb.WriteByte('%')
+			continue
+		case body[i] == '{':
+			end := strings.IndexByte(body[i:], '}')
+			if end < 0 {
+				return "", false
+			}
+			ref = body[i+1 : i+end]
+			i += end
+			if strings.HasPrefix(ref, "?") {
+				optional = true
+				ref = ref[1:]
+			}
+		default:
+			end := i
+			for end < len(body) && (body[end] == '_' || body[end] >= 'a' && body[end] <= 'z' ||
+				body[end] >= 'A' && body[end] <= 'Z' || body[end] >= '0' && body[end] <= '9') {
+				end++
+			}
+			ref = body[i:end]
+			i = end - 1
+		}
+
+		value, ok := expandMacroDepth(macros, ref, depth+1)
+		if !ok && !optional {
+			return "", false
+		}
+		sb.WriteString(value)
+	}
+	return sb.String(), true
+}
+
+// readLinkFS is implemented by filesystems that can report symbolic links. It has
+// the same method set as io/fs.ReadLinkFS.
+type readLinkFS interface {
+	fs.FS
+	ReadLink(name string) (string, error)
+	Lstat(name string) (fs.FileInfo, error)
+}
+
+// DirFS returns the directory tree rooted at dir as a filesystem, like os.DirFS,
+// that also reports symbolic links and, on Linux, extended attributes, which
+// OpenRootFS and Verify use.
+func DirFS(dir string) fs.FS {
+	return rootDirFS{FS: os.DirFS(dir), root: dir}
+}
+
+// rootDirFS is os.DirFS with symbolic link support.
+type rootDirFS struct {
+	fs.FS
+	root string
+}
+
+func (r rootDirFS) ReadLink(name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: "readlink", Path: name, Err: fs.ErrInvalid}
+	}
+	return os.Readlink(filepath.Join(r.root, filepath.FromSlash(name)))
+}
+
+func (r rootDirFS) Lstat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "lstat", Path: name, Err: fs.ErrInvalid}
+	}
+	return os.Lstat(filepath.Join(r.root, filepath.FromSlash(name)))
+}
+
+// resolvePath converts the absolute path p into a name within fsys, following
+// symbolic links as if fsys were the root directory, so that absolute link
+// targets in an image never point back to the host.
+func resolvePath(fsys fs.FS, p string) (string, error) {
+	name := strings.TrimPrefix(path.Clean("/"+p), "/")
+	if name == "" {
+		name = "."
+	}
+
+	lfs, ok := fsys.(readLinkFS)
+	if !ok {
+		return name, nil
+	}
+
+	// same limit as Linux, ref. MAXSYMLINKS
+	const maxSymlinks = 40
+
+	resolved := "."
+	rest := strings.Split(name, "/")
+	for links := 0; len(rest) > 0; {
+		elem := rest[0]
+		rest = rest[1:]
+
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			resolved = path.Dir(resolved)
+			continue
+		}
+
+		next := path.Join(resolved, elem)
+		fi, err := lfs.Lstat(next)
+		if err != nil {
+			return "", err
+		}
+		if fi.Mode()&fs.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+
+		if links++; links > maxSymlinks {
+			return "", xerrors.Errorf("too many levels of symbolic links: %s", p)
+		}
+		target, err := lfs.ReadLink(next)
+		if err != nil {
+			return "", err
+		}
+		if path.IsAbs(target) {
+			resolved = "."
+		}
+		rest = append(strings.Split(target, "/"), rest...)
+	}
+	return resolved, nil
+}
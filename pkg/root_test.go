@@ -0,0 +1,180 @@
+package rpmdb
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenRoot(t *testing.T) {
+	bdbData, err := os.ReadFile("testdata/libuuid/Packages")
+	require.NoError(t, err)
+	sqliteData, err := os.ReadFile("testdata/cbl-mariner-2.0/rpmdb.sqlite")
+	require.NoError(t, err)
+
+	type file struct {
+		data    []byte
+		symlink string
+	}
+	tests := []struct {
+		name       string
+		files      map[string]file
+		wantPath   string
+		wantFormat Format
+		wantErr    error
+	}{
+		{
+			name: "classic location",
+			files: map[string]file{
+				"var/lib/rpm/Packages": {data: bdbData},
+			},
+			wantPath:   "var/lib/rpm/Packages",
+			wantFormat: FormatBerkeleyDB,
+		},
+		{
+			name: "relocated to /usr with an absolute compat symlink",
+			files: map[string]file{
+				"usr/lib/sysimage/rpm/rpmdb.sqlite": {data: sqliteData},
+				"var/lib/rpm":                       {symlink: "/usr/lib/sysimage/rpm"},
+				"usr/lib/rpm/macros":                {data: []byte("%_var\t/var\n%_dbpath\t\t%{_var}/lib/rpm\n")},
+			},
+			wantPath:   "usr/lib/sysimage/rpm/rpmdb.sqlite",
+			wantFormat: FormatSQLite,
+		},
+		{
+			name: "rpm-ostree",
+			files: map[string]file{
+				"usr/share/rpm/Packages": {data: bdbData},
+			},
+			wantPath:   "usr/share/rpm/Packages",
+			wantFormat: FormatBerkeleyDB,
+		},
+		{
+			name: "dbpath overridden in /etc",
+			files: map[string]file{
+				"var/lib/rpm/Packages":               {data: bdbData},
+				"opt/rpmdb/rpmdb.sqlite":             {data: sqliteData},
+				"usr/lib/rpm/macros":                 {data: []byte("%_dbpath %{_var}/lib/rpm\n")},
+				"etc/rpm/macros.dbpath":              {data: []byte("# custom location\n%_dbpath %{?_unset}/opt/rpmdb\n")},
+				"usr/lib/rpm/macros.d/macros.vendor": {data: []byte("%_dbpath /nowhere\n")},
+			},
+			wantPath:   "opt/rpmdb/rpmdb.sqlite",
+			wantFormat: FormatSQLite,
+		},
+		{
+			name: "configured backend wins over autodetection",
+			files: map[string]file{
+				"var/lib/rpm/Packages":     {data: bdbData},
+				"var/lib/rpm/rpmdb.sqlite": {data: sqliteData},
+				"usr/lib/rpm/macros":       {data: []byte("%_db_backend \\\n  bdb\n")},
+			},
+			wantPath:   "var/lib/rpm/Packages",
+			wantFormat: FormatBerkeleyDB,
+		},
+		{
+			name: "autodetection follows rpm's backend order",
+			files: map[string]file{
+				"var/lib/rpm/Packages":     {data: bdbData},
+				"var/lib/rpm/rpmdb.sqlite": {data: sqliteData},
+			},
+			wantPath:   "var/lib/rpm/rpmdb.sqlite",
+			wantFormat: FormatSQLite,
+		},
+		{
+			name: "macro line too long",
+			files: map[string]file{
+				"var/lib/rpm/Packages": {data: bdbData},
+				"usr/lib/rpm/macros":   {data: []byte("%_dbpath /var/lib/rpm " + strings.Repeat("x", bufio.MaxScanTokenSize) + "\n")},
+			},
+			wantErr: bufio.ErrTooLong,
+		},
+		{
+			name: "no database",
+			files: map[string]file{
+				"etc/os-release": {data: []byte("ID=scratch\n")},
+			},
+			wantErr: ErrorRpmDBNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			mapFS := fstest.MapFS{}
+			for name, f := range tt.files {
+				p := filepath.Join(root, filepath.FromSlash(name))
+				require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+				if f.symlink != "" {
+					if err := os.Symlink(f.symlink, p); err != nil {
+						t.Skipf("symlinks are not supported: %s", err)
+					}
+					continue
+				}
+				require.NoError(t, os.WriteFile(p, f.data, 0o644))
+				mapFS[name] = &fstest.MapFile{Data: f.data}
+			}
+
+			db, err := OpenRoot(root)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			defer db.Close()
+
+			assert.Equal(t, filepath.Join(root, filepath.FromSlash(tt.wantPath)), db.Path())
+			assert.Equal(t, tt.wantFormat, db.Format())
+
+			pkgs, err := db.ListPackages()
+			require.NoError(t, err)
+			assert.NotEmpty(t, pkgs)
+
+			// fstest.MapFS cannot represent symlinks
+			for _, f := range tt.files {
+				if f.symlink != "" {
+					return
+				}
+			}
+			fsdb, err := OpenRootFS(mapFS)
+			require.NoError(t, err)
+			defer fsdb.Close()
+			assert.Equal(t, tt.wantPath, fsdb.Path())
+			assert.Equal(t, tt.wantFormat, fsdb.Format())
+		})
+	}
+}
+
+func TestOpenRoot_macrosOutsideRoot(t *testing.T) {
+	bdbData, err := os.ReadFile("testdata/libuuid/Packages")
+	require.NoError(t, err)
+	sqliteData, err := os.ReadFile("testdata/cbl-mariner-2.0/rpmdb.sqlite")
+	require.NoError(t, err)
+
+	root := t.TempDir()
+	for name, data := range map[string][]byte{
+		"var/lib/rpm/Packages":   bdbData,
+		"opt/rpmdb/rpmdb.sqlite": sqliteData,
+	} {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, data, 0o644))
+	}
+
+	// a macro file of the host, which must not be read through the image
+	host := filepath.Join(t.TempDir(), "macros.dist")
+	require.NoError(t, os.WriteFile(host, []byte("%_dbpath /opt/rpmdb\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "etc", "rpm"), 0o755))
+	if err := os.Symlink(host, filepath.Join(root, "etc", "rpm", "macros.dist")); err != nil {
+		t.Skipf("symlinks are not supported: %s", err)
+	}
+
+	db, err := OpenRoot(root)
+	require.NoError(t, err)
+	defer db.Close()
+	assert.Equal(t, filepath.Join(root, "var", "lib", "rpm", "Packages"), db.Path())
+}
@@ -1,6 +1,13 @@
 package rpmdb
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"iter"
+	"sync"
+
 	"github.com/knqyf263/go-rpmdb/pkg/bdb"
 	dbi "github.com/knqyf263/go-rpmdb/pkg/db"
 	"github.com/knqyf263/go-rpmdb/pkg/ndb"
@@ -9,17 +16,40 @@ import (
 )
 
 type RpmDB struct {
-	db dbi.RpmDBInterface
+	db     dbi.RpmDBInterface
+	closer io.Closer
+	path   string
+	format Format
+
+	verifyDigests bool
+
+	// the indexes are built from all headers by the first query needing them
+	indexMu sync.Mutex
+	index   *packageIndex
+	files   *fileIndex
 }
 
 func Open(path string) (*RpmDB, error) {
+	return OpenContext(context.Background(), path)
+}
+
+// OpenContext is like Open, but gives up as soon as ctx is done.
+func OpenContext(ctx context.Context, path string) (*RpmDB, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// SQLite3 Open() returns nil, nil in case of DB format other than SQLite3
-	sqldb, err := sqlite3.Open(path)
+	sqldb, err := sqlite3.OpenContext(ctx, path)
 	if err != nil && !xerrors.Is(err, sqlite3.ErrorInvalidSQLite3) {
 		return nil, err
 	}
 	if sqldb != nil {
-		return &RpmDB{db: sqldb}, nil
+		return &RpmDB{db: sqldb, path: path, format: FormatSQLite}, nil
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
 	}
 
 	// NDB Open() returns nil, nil in case of DB format other than NDB
@@ -28,7 +58,11 @@ func Open(path string) (*RpmDB, error) {
 		return nil, err
 	}
 	if ndbh != nil {
-		return &RpmDB{db: ndbh}, nil
+		return &RpmDB{db: ndbh, path: path, format: FormatNDB}, nil
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
 	}
 
 	odb, err := bdb.Open(path)
@@ -37,47 +71,340 @@ func Open(path string) (*RpmDB, error) {
 	}
 
 	return &RpmDB{
-		db: odb,
+		db:     odb,
+		path:   path,
+		format: FormatBerkeleyDB,
 	}, nil
 
 }
 
+// OpenFS opens the rpmdb stored at name in fsys, e.g. a database inside a
+// container image layer that has not been extracted to disk.
+func OpenFS(fsys fs.FS, name string) (*RpmDB, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := f.(io.ReaderAt)
+	if !ok {
+		defer f.Close()
+
+		b, err := io.ReadAll(f)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read %s: %w", name, err)
+		}
+		db, err := OpenBytes(b)
+		if err != nil {
+			return nil, err
+		}
+		db.path = name
+		return db, nil
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, xerrors.Errorf("failed to stat %s: %w", name, err)
+	}
+
+	db, err := OpenReaderAt(r, fi.Size())
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	db.closer = f
+	db.path = name
+
+	return db, nil
+}
+
+// OpenReaderAt opens an rpmdb of the given size held in r. Closing the returned
+// RpmDB does not close r.
+func OpenReaderAt(r io.ReaderAt, size int64) (*RpmDB, error) {
+	sqldb, err := sqlite3.OpenReaderAt(r, size)
+	if err != nil && !xerrors.Is(err, sqlite3.ErrorInvalidSQLite3) {
+		return nil, err
+	}
+	if sqldb != nil {
+		return &RpmDB{db: sqldb, format: FormatSQLite}, nil
+	}
+
+	ndbh, err := ndb.OpenReaderAt(r, size)
+	if err != nil && !xerrors.Is(err, ndb.ErrorInvalidNDB) {
+		return nil, err
+	}
+	if ndbh != nil {
+		return &RpmDB{db: ndbh, format: FormatNDB}, nil
+	}
+
+	odb, err := bdb.OpenReaderAt(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RpmDB{
+		db:     odb,
+		format: FormatBerkeleyDB,
+	}, nil
+}
+
+// OpenBytes opens an rpmdb that has been loaded into memory.
+func OpenBytes(b []byte) (*RpmDB, error) {
+	return OpenReaderAt(bytes.NewReader(b), int64(len(b)))
+}
+
+// Path returns the location the database was opened from, or an empty string if
+// it was opened from a reader.
+func (d *RpmDB) Path() string {
+	return d.path
+}
+
+// Format returns the storage backend of the database.
+func (d *RpmDB) Format() Format {
+	return d.format
+}
+
 func (d *RpmDB) Close() error {
-	return d.db.Close()
+	err := d.db.Close()
+	if d.closer != nil {
+		if cErr := d.closer.Close(); err == nil {
+			err = cErr
+		}
+	}
+	return err
 }
 
+// Package returns the installed package called name, looking it up in the Name
+// index of the database. If several instances are installed, e.g. kernels or
+// multilib packages, the first one is returned; see Packages.
 func (d *RpmDB) Package(name string) (*PackageInfo, error) {
-	pkgs, err := d.ListPackages()
+	pkgs, err := d.Packages(name)
+	if err != nil {
+		return nil, err
+	}
+	return pkgs[0], nil
+}
+
+// Packages returns every installed instance of the package called name, such as
+// the installed kernels or both glibc.i686 and glibc.x86_64.
+func (d *RpmDB) Packages(name string) ([]*PackageInfo, error) {
+	return d.PackagesContext(context.Background(), name)
+}
+
+// PackagesContext is like Packages, but gives up once ctx is done.
+func (d *RpmDB) PackagesContext(ctx context.Context, name string) ([]*PackageInfo, error) {
+	pkgs, err := d.packages(ctx, name, func(*PackageInfo) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) == 0 {
+		return nil, xerrors.Errorf("%s is not installed", name)
+	}
+	return pkgs, nil
+}
+
+// PackagesByNameArch returns the installed instances of the package called name
+// built for arch, e.g. glibc and i686.
+func (d *RpmDB) PackagesByNameArch(name, arch string) ([]*PackageInfo, error) {
+	pkgs, err := d.packages(context.Background(), name, func(pkg *PackageInfo) bool { return pkg.Arch == arch })
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) == 0 {
+		return nil, xerrors.Errorf("%s.%s is not installed", name, arch)
+	}
+	return pkgs, nil
+}
+
+// PackageByNEVRA returns the package identified by a string such as
+// "bash-5.1.8-6.el9.x86_64", as printed by rpm -q. The epoch is only compared if
+// it is given, as in "openssl-1:3.0.7-1.el9.x86_64" or "1:openssl-3.0.7-1.el9.x86_64".
+func (d *RpmDB) PackageByNEVRA(nevra string) (*PackageInfo, error) {
+	want, err := ParseNEVRA(nevra)
+	if err != nil {
+		return nil, err
+	}
+	pkgs, err := d.packages(context.Background(), want.Name, func(pkg *PackageInfo) bool {
+		return pkg.Version == want.Version && pkg.Release == want.Release && pkg.Arch == want.Arch &&
+			(want.Epoch == nil || pkg.EpochNum() == *want.Epoch)
+	})
 	if err != nil {
-		return nil, xerrors.Errorf("unable to list packages: %w", err)
+		return nil, err
+	}
+	if len(pkgs) == 0 {
+		return nil, xerrors.Errorf("%s is not installed", nevra)
 	}
+	return pkgs[0], nil
+}
+
+// PackageByID returns the package stored under the header number id, the
+// PackageInfo.DBInstance of a package and the number rpm prints in its error
+// messages. Only that header is read if the backend supports it.
+func (d *RpmDB) PackageByID(id uint32) (*PackageInfo, error) {
+	return d.PackageByIDContext(context.Background(), id)
+}
 
-	for _, pkg := range pkgs {
-		if pkg.Name == name {
-			return pkg, nil
+// PackageByIDContext is like PackageByID, but gives up once ctx is done.
+func (d *RpmDB) PackageByIDContext(ctx context.Context, id uint32) (*PackageInfo, error) {
+	indexer, ok := d.db.(dbi.Indexer)
+	if !ok {
+		for pkg, err := range d.AllContext(ctx) {
+			if err != nil {
+				return nil, xerrors.Errorf("unable to list packages: %w", err)
+			}
+			if pkg.DBInstance == id {
+				return pkg, nil
+			}
 		}
+		return nil, xerrors.Errorf("header %d: %w", id, dbi.ErrorHeaderNotFound)
+	}
+
+	blob, err := indexer.GetContext(ctx, id)
+	if err != nil {
+		return nil, err
 	}
-	return nil, xerrors.Errorf("%s is not installed", name)
+	h, err := d.readHeader(id, blob)
+	if err != nil {
+		return nil, err
+	}
+	return h.PackageInfo()
+}
+
+// packages returns the installed instances of the package called name which
+// match.
+func (d *RpmDB) packages(ctx context.Context, name string, match func(*PackageInfo) bool) ([]*PackageInfo, error) {
+	hasName := func(pkg *PackageInfo) bool { return pkg.Name == name }
+	return d.lookup(ctx, dbi.IndexName, name, hasName, func(pkg *PackageInfo) bool {
+		return hasName(pkg) && match(pkg)
+	})
 }
 
 func (d *RpmDB) ListPackages() ([]*PackageInfo, error) {
-	var pkgList []*PackageInfo
+	return d.ListPackagesContext(context.Background())
+}
 
-	for entry := range d.db.Read() {
-		if entry.Err != nil {
-			return nil, entry.Err
-		}
+// ListPackagesContext is like ListPackages, but stops reading the database as
+// soon as ctx is done.
+func (d *RpmDB) ListPackagesContext(ctx context.Context) ([]*PackageInfo, error) {
+	var pkgList []*PackageInfo
 
-		indexEntries, err := headerImport(entry.Value)
-		if err != nil {
-			return nil, xerrors.Errorf("error during importing header: %w", err)
-		}
-		pkg, err := getNEVRA(indexEntries)
+	for pkg, err := range d.AllContext(ctx) {
 		if err != nil {
-			return nil, xerrors.Errorf("invalid package info: %w", err)
+			return nil, err
 		}
 		pkgList = append(pkgList, pkg)
 	}
 
 	return pkgList, nil
 }
+
+// All returns an iterator over the installed packages. Headers are decoded one at
+// a time as the iteration advances, so the caller can stop at any point without
+// reading the rest of the database into memory. Errors are yielded per entry and
+// it is up to the caller whether to keep iterating.
+func (d *RpmDB) All() iter.Seq2[*PackageInfo, error] {
+	return d.AllContext(context.Background())
+}
+
+// AllContext is like All, but the iteration ends with ctx.Err() once ctx is done.
+func (d *RpmDB) AllContext(ctx context.Context) iter.Seq2[*PackageInfo, error] {
+	return func(yield func(*PackageInfo, error) bool) {
+		for h, err := range d.HeadersContext(ctx) {
+			if err != nil {
+				if !yield(nil, err) {
+					return
+				}
+				continue
+			}
+
+			if !yield(h.PackageInfo()) {
+				return
+			}
+		}
+	}
+}
+
+// Headers is like All, but yields the raw package headers so that tags not
+// covered by PackageInfo can be read.
+func (d *RpmDB) Headers() iter.Seq2[*Header, error] {
+	return d.HeadersContext(context.Background())
+}
+
+// HeadersContext is like Headers, but the iteration ends with ctx.Err() once ctx
+// is done.
+func (d *RpmDB) HeadersContext(ctx context.Context) iter.Seq2[*Header, error] {
+	return func(yield func(*Header, error) bool) {
+		// cancelling releases the backend goroutine when the caller stops early
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var entries <-chan dbi.Entry
+		if reader, ok := d.db.(dbi.ContextReader); ok {
+			entries = reader.ReadContext(ctx)
+		} else {
+			// Read cannot be stopped, so the rest of its entries are discarded in
+			// the background to let the backend goroutine finish
+			entries = d.db.Read()
+			defer func() {
+				go func() {
+					for range entries {
+					}
+				}()
+			}()
+		}
+
+		for {
+			var entry dbi.Entry
+			var ok bool
+			select {
+			case entry, ok = <-entries:
+			case <-ctx.Done():
+			}
+			if !ok {
+				break
+			}
+
+			if entry.Err != nil {
+				if !yield(nil, entry.Err) {
+					return
+				}
+				continue
+			}
+
+			if !yield(d.readHeader(entry.HeaderNum, entry.Value)) {
+				return
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// VerifyHeaderDigests turns on checking the SHA1 and SHA256 digests that cover the
+// immutable region of every header read afterwards, so that a corrupted or
+// tampered entry is reported as an error wrapping ErrorHeaderDigestMismatch
+// instead of being trusted. Set it before querying the database, since some
+// queries keep the packages they have read.
+func (d *RpmDB) VerifyHeaderDigests(verify bool) {
+	d.verifyDigests = verify
+}
+
+// readHeader parses the header stored under hnum.
+func (d *RpmDB) readHeader(hnum uint32, blob []byte) (*Header, error) {
+	h, err := parseHeader(blob)
+	if err != nil {
+		return nil, err
+	}
+	h.instance = hnum
+
+	if d.verifyDigests {
+		if err := h.verifyDigests(); err != nil {
+			name, _ := h.GetString(RPMTAG_NAME)
+			return nil, xerrors.Errorf("%s (header %d): %w", name, hnum, err)
+		}
+	}
+	return h, nil
+}
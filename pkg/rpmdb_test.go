@@ -1,10 +1,17 @@
 package rpmdb
 
 import (
+	"bytes"
+	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"os"
+	"path"
 	"testing"
+	"testing/fstest"
+	"time"
 
+	dbi "github.com/knqyf263/go-rpmdb/pkg/db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -104,27 +111,214 @@ func TestPackageList(t *testing.T) {
 			got, err := db.ListPackages()
 			require.NoError(t, err)
 
-			// They are tested in another function.
-			for _, g := range got {
-				g.PGP = ""
-				g.RSAHeader = ""
-				g.DigestAlgorithm = 0
-				g.InstallTime = 0
-				g.BaseNames = nil
-				g.DirIndexes = nil
-				g.DirNames = nil
-				g.FileSizes = nil
-				g.FileDigests = nil
-				g.FileModes = nil
-				g.FileFlags = nil
-				g.UserNames = nil
-				g.GroupNames = nil
-				g.Provides = nil
-				g.Requires = nil
+			for i, p := range tt.pkgList {
+				assert.Equal(t, p, commonFields(got[i]))
 			}
+		})
+	}
+}
 
-			for i, p := range tt.pkgList {
-				assert.Equal(t, p, got[i])
+func TestRpmDB_All(t *testing.T) {
+	tests := []struct {
+		name string
+		file string // Test input file
+	}{
+		{
+			name: "BerkeleyDB",
+			file: "testdata/libuuid/Packages",
+		},
+		{
+			name: "NDB",
+			file: "testdata/sle15-bci/Packages.db",
+		},
+		{
+			name: "SQLite3",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			want, err := db.ListPackages()
+			require.NoError(t, err)
+			require.NoError(t, db.Close())
+
+			db, err = Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			var got []*PackageInfo
+			for pkg, err := range db.All() {
+				require.NoError(t, err)
+				got = append(got, pkg)
+			}
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestRpmDB_All_stopEarly(t *testing.T) {
+	db, err := Open("testdata/sle15-bci/Packages.db")
+	require.NoError(t, err)
+	defer db.Close()
+
+	var count int
+	for pkg, err := range db.All() {
+		require.NoError(t, err)
+		require.NotNil(t, pkg)
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	assert.Equal(t, 3, count)
+}
+
+func TestRpmDB_AllContext(t *testing.T) {
+	for _, file := range []string{
+		"testdata/libuuid/Packages",
+		"testdata/sle15-bci/Packages.db",
+		"testdata/cbl-mariner-2.0/rpmdb.sqlite",
+	} {
+		t.Run(file, func(t *testing.T) {
+			db, err := Open(file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var lastErr error
+			for pkg, err := range db.AllContext(ctx) {
+				if err != nil {
+					lastErr = err
+					break
+				}
+				require.NotNil(t, pkg)
+				cancel()
+			}
+			assert.ErrorIs(t, lastErr, context.Canceled)
+		})
+	}
+}
+
+// readOnlyDB hides the ReadContext method of a backend, like a third-party
+// implementation of dbi.RpmDBInterface.
+type readOnlyDB struct {
+	dbi.RpmDBInterface
+}
+
+func TestRpmDB_AllContext_withoutContextReader(t *testing.T) {
+	db, err := Open("testdata/sle15-bci/Packages.db")
+	require.NoError(t, err)
+	defer db.Close()
+	want, err := db.ListPackages()
+	require.NoError(t, err)
+
+	db.db = readOnlyDB{db.db}
+	var got []*PackageInfo
+	for pkg, err := range db.All() {
+		require.NoError(t, err)
+		got = append(got, pkg)
+	}
+	assert.Equal(t, want, got)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var lastErr error
+	for _, err := range db.AllContext(ctx) {
+		lastErr = err
+	}
+	assert.ErrorIs(t, lastErr, context.Canceled)
+
+	// stopping early must not leave the backend blocked on sending
+	drained := make(chan struct{})
+	db.db = drainedDB{RpmDBInterface: readOnlyDB{db.db}, drained: drained}
+	for _, err := range db.All() {
+		require.NoError(t, err)
+		break
+	}
+	select {
+	case <-drained:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the entries of Read were not drained")
+	}
+}
+
+// drainedDB closes drained once all the entries of Read have been received.
+type drainedDB struct {
+	dbi.RpmDBInterface
+	drained chan struct{}
+}
+
+func (db drainedDB) Read() <-chan dbi.Entry {
+	entries := make(chan dbi.Entry)
+	go func() {
+		defer close(db.drained)
+		defer close(entries)
+		for entry := range db.RpmDBInterface.Read() {
+			entries <- entry
+		}
+	}()
+	return entries
+}
+
+func TestOpenContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := OpenContext(ctx, "testdata/sle15-bci/Packages.db")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	db, err := OpenContext(context.Background(), "testdata/sle15-bci/Packages.db")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ListPackagesContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestOpenFS(t *testing.T) {
+	for _, name := range []string{
+		"libuuid/Packages",
+		"sle15-bci/Packages.db",
+		"cbl-mariner-2.0/rpmdb.sqlite",
+	} {
+		t.Run(name, func(t *testing.T) {
+			db, err := Open(path.Join("testdata", name))
+			require.NoError(t, err)
+			want, err := db.ListPackages()
+			require.NoError(t, err)
+			require.NoError(t, db.Close())
+
+			b, err := os.ReadFile(path.Join("testdata", name))
+			require.NoError(t, err)
+
+			opens := map[string]func() (*RpmDB, error){
+				"DirFS": func() (*RpmDB, error) {
+					return OpenFS(os.DirFS("testdata"), name)
+				},
+				"MapFS": func() (*RpmDB, error) {
+					return OpenFS(fstest.MapFS{"var/lib/rpm/db": {Data: b}}, "var/lib/rpm/db")
+				},
+				"ReaderAt": func() (*RpmDB, error) {
+					return OpenReaderAt(bytes.NewReader(b), int64(len(b)))
+				},
+				"Bytes": func() (*RpmDB, error) {
+					return OpenBytes(b)
+				},
+			}
+			for source, open := range opens {
+				t.Run(source, func(t *testing.T) {
+					db, err := open()
+					require.NoError(t, err)
+
+					got, err := db.ListPackages()
+					require.NoError(t, err)
+					assert.Equal(t, want, got)
+					assert.NoError(t, db.Close())
+				})
 			}
 		})
 	}
@@ -224,6 +418,8 @@ func TestRpmDB_Package(t *testing.T) {
 		want                   *PackageInfo
 		wantInstalledFiles     []FileInfo
 		wantInstalledFileNames []string
+		wantChangelogLen       int
+		wantChangelog          ChangelogEntry // the newest entry
 		wantErr                string
 	}{
 		{
@@ -243,6 +439,7 @@ func TestRpmDB_Package(t *testing.T) {
 				SigMD5:      "ebfb56be33b146ef39180a090e581258",
 				PGP:         "",
 				InstallTime: 1459411575,
+				DBInstance:  61,
 				Provides: []string{
 					"Distutils",
 					"python(abi)",
@@ -251,6 +448,12 @@ func TestRpmDB_Package(t *testing.T) {
 					"python2",
 					"python",
 				},
+				ProvideFlags: []int32{
+					0, 8, 8, 8, 8, 8,
+				},
+				ProvideVersions: []string{
+					"", "2.4", "2.4", "2.4.3-56.el5", "2.4.3", "2.4.3-56.el5",
+				},
 				Requires: []string{
 					"/usr/bin/env",
 					"libc.so.6()(64bit)",
@@ -267,9 +470,32 @@ func TestRpmDB_Package(t *testing.T) {
 					"rpmlib(VersionedDependencies)",
 					"rtld(GNU_HASH)",
 				},
+				RequireFlags: []int32{
+					16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 8, 16777290, 16777290,
+					16777290, 16777290, 16384,
+				},
+				RequireVersions: []string{
+					"", "", "", "", "", "", "", "", "2.4.3-56.el5", "3.0.4-1", "4.0.4-1", "4.0-1",
+					"3.0.3-1", "",
+				},
+				Obsoletes: []string{
+					"Distutils", "python2",
+				},
+				ObsoleteFlags: []int32{
+					0, 0,
+				},
+				ObsoleteVersions: []string{
+					"", "",
+				},
 			},
 			wantInstalledFiles:     CentOS5PythonInstalledFiles,
 			wantInstalledFileNames: CentOS5PythonInstalledFileNames,
+			wantChangelogLen:       196,
+			wantChangelog: ChangelogEntry{
+				Time: time.Date(2012, 10, 24, 22, 0, 0, 0, time.UTC),
+				Name: "David Malcolm <dmalcolm@redhat.com> - 2.4.3-56",
+				Text: "- give gdb debug hooks an extra way to locate the Python frame\nRelated: rhbz#644661",
+			},
 		},
 		{
 			name:    "centos6 glibc",
@@ -290,6 +516,7 @@ func TestRpmDB_Package(t *testing.T) {
 				PGP:             "RSA/SHA1, Wed Jun 20 11:36:27 2018, Key ID 0946fca2c105b9de",
 				RSAHeader:       "RSA/SHA1, Wed Jun 20 11:36:27 2018, Key ID 0946fca2c105b9de",
 				InstallTime:     1538857091,
+				DBInstance:      10,
 				Provides: []string{
 					"ANSI_X3.110.so()(64bit)",
 					"ARMSCII-8.so()(64bit)",
@@ -614,6 +841,53 @@ func TestRpmDB_Package(t *testing.T) {
 					"glibc",
 					"glibc(x86-64)",
 				},
+				ProvideFlags: []int32{
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 268435464, 32768, 32768, 32768, 32768, 0, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768, 32768,
+					32768, 32768, 32768, 32768, 32768, 32768, 32768, 0, 8, 8,
+				},
+				ProvideVersions: []string{
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "2.12-1.212.el6", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "2.12-1.212.el6", "2.12-1.212.el6",
+				},
 				Requires: []string{
 					"/sbin/ldconfig",
 					"/usr/sbin/glibc_post_upgrade.x86_64",
@@ -668,9 +942,46 @@ func TestRpmDB_Package(t *testing.T) {
 					"rpmlib(VersionedDependencies)",
 					"rpmlib(PayloadIsXz)",
 				},
+				RequireFlags: []int32{
+					4352, 1280, 512, 268435464, 8, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					16384, 16384, 16384, 16384, 512, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16777226,
+					16777226, 16777226, 16777226, 16777226, 16777226,
+				},
+				RequireVersions: []string{
+					"", "", "", "2.12-1.212.el6", "2.12-1.212.el6", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "3.0.4-1", "4.6.0-1", "4.0.4-1",
+					"4.0-1", "3.0.3-1", "5.2-1",
+				},
+				Conflicts: []string{
+					"binutils", "prelink",
+				},
+				ConflictFlags: []int32{
+					2, 2,
+				},
+				ConflictVersions: []string{
+					"2.19.51.0.10", "0.4.2",
+				},
+				Obsoletes: []string{
+					"glibc-profile",
+				},
+				ObsoleteFlags: []int32{
+					2,
+				},
+				ObsoleteVersions: []string{
+					"2.4",
+				},
 			},
 			wantInstalledFiles:     CentOS6GlibcInstalledFiles,
 			wantInstalledFileNames: CentOS6GlibcInstalledFileNames,
+			wantChangelogLen:       850,
+			wantChangelog: ChangelogEntry{
+				Time: time.Date(2017, 11, 17, 12, 0, 0, 0, time.UTC),
+				Name: "Patsy Franklin <pfrankli@redhat.com> - 2.12-1.212",
+				Text: "- CVE-2017-15670: glob: Fix one-byte overflow with GLOB_TILDE (#1504810)\n- CVE-2017-15804: glob: Fix buffer overflow in GLOB_TILDE unescaping (#1504810)",
+			},
 		},
 		{
 			name:    "centos8 nodejs",
@@ -693,6 +1004,7 @@ func TestRpmDB_Package(t *testing.T) {
 				RSAHeader:       "RSA/SHA256, Tue Jul  7 16:08:24 2020, Key ID 05b555b38483c65d",
 				DigestAlgorithm: PGPHASHALGO_SHA256,
 				InstallTime:     1606911097,
+				DBInstance:      176,
 				Provides: []string{
 					"bundled(brotli)",
 					"bundled(c-ares)",
@@ -711,6 +1023,14 @@ func TestRpmDB_Package(t *testing.T) {
 					"nodejs-punycode",
 					"npm(punycode)",
 				},
+				ProvideFlags: []int32{
+					8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+				},
+				ProvideVersions: []string{
+					"1.0.7", "1.15.0", "2.9.3", "64.2", "1.34.2", "1.41.0", "6.8.275.32",
+					"1:10.21.0-3.module_el8.2.0+391+8da3adc6", "10.21", "10.21", "10.21.0", "6.8",
+					"6.8", "1:10.21.0-3.module_el8.2.0+391+8da3adc6", "2.1.0", "2.1.0",
+				},
 				Requires: []string{
 					"/bin/sh",
 					"ca-certificates",
@@ -767,9 +1087,46 @@ func TestRpmDB_Package(t *testing.T) {
 					"rpmlib(PayloadIsXz)",
 					"rtld(GNU_HASH)",
 				},
+				RequireFlags: []int32{
+					16384, 0, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					8, 16777226, 16777226, 16777226, 16777226, 16384,
+				},
+				RequireVersions: []string{
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"", "", "", "", "", "", "1:6.14.4-1.10.21.0.3.module_el8.2.0+391+8da3adc6",
+					"3.0.4-1", "4.6.0-1", "4.0-1", "5.2-1", "",
+				},
+				Conflicts: []string{
+					"node",
+				},
+				ConflictFlags: []int32{
+					10,
+				},
+				ConflictVersions: []string{
+					"0.3.2-12",
+				},
+				Recommends: []string{
+					"nodejs-full-i18n(x86-64)",
+				},
+				RecommendFlags: []int32{
+					8,
+				},
+				RecommendVersions: []string{
+					"1:10.21.0-3.module_el8.2.0+391+8da3adc6",
+				},
 			},
 			wantInstalledFiles:     CentOS8NodejsInstalledFiles,
 			wantInstalledFileNames: CentOS8NodejsInstalledFileNames,
+			wantChangelogLen:       61,
+			wantChangelog: ChangelogEntry{
+				Time: time.Date(2020, 6, 17, 12, 0, 0, 0, time.UTC),
+				Name: "Zuzana Svetlikova <zsvetlik@redhat.com> - 1:10.21.0-3",
+				Text: "- Resolves: RHBZ#1845306\n- Remove brotli-devel requires from nodejs-devel",
+			},
 		},
 		{
 			name:    "CBL-Mariner 2.0 curl",
@@ -790,10 +1147,17 @@ func TestRpmDB_Package(t *testing.T) {
 				PGP:             "RSA/SHA256, Thu Jan 27 09:02:11 2022, Key ID 0cd9fed33135ce90",
 				RSAHeader:       "RSA/SHA256, Thu Jan 27 09:02:11 2022, Key ID 0cd9fed33135ce90",
 				InstallTime:     1643279454,
+				DBInstance:      48,
 				Provides: []string{
 					"curl",
 					"curl(x86-64)",
 				},
+				ProvideFlags: []int32{
+					8, 8,
+				},
+				ProvideVersions: []string{
+					"7.76.0-6.cm2", "7.76.0-6.cm2",
+				},
 				Requires: []string{
 					"/bin/sh",
 					"/sbin/ldconfig",
@@ -817,9 +1181,23 @@ func TestRpmDB_Package(t *testing.T) {
 					"rpmlib(FileDigests)",
 					"rpmlib(PayloadFilesHavePrefix)",
 				},
+				RequireFlags: []int32{
+					16384, 1280, 4352, 8, 0, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					16384, 16384, 0, 16384, 0, 16777226, 16777226, 16777226,
+				},
+				RequireVersions: []string{
+					"", "", "", "7.76.0-6.cm2", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+					"3.0.4-1", "4.6.0-1", "4.0-1",
+				},
 			},
 			wantInstalledFiles:     Mariner2CurlInstalledFiles,
 			wantInstalledFileNames: Mariner2CurlInstalledFileNames,
+			wantChangelogLen:       42,
+			wantChangelog: ChangelogEntry{
+				Time: time.Date(2021, 12, 16, 12, 0, 0, 0, time.UTC),
+				Name: "Pawel Winogrodzki <pawelwi@microsoft.com> - 7.76.0-6",
+				Text: "- Removing the explicit %clean stage.",
+			},
 		},
 		{
 			name:    "Rockylinux 9 bash",
@@ -840,10 +1218,17 @@ func TestRpmDB_Package(t *testing.T) {
 				PGP:             "", // this is legacy at this point
 				RSAHeader:       "RSA/SHA256, Sat May 14 23:43:48 2022, Key ID 702d426d350d275d",
 				InstallTime:     1700432743,
+				DBInstance:      140,
 				Provides: []string{
 					"hostname",
 					"hostname(aarch-64)",
 				},
+				ProvideFlags: []int32{
+					8, 8,
+				},
+				ProvideVersions: []string{
+					"3.23-6.el9", "3.23-6.el9",
+				},
 				Requires: []string{"/bin/sh",
 					"/bin/sh",
 					"/usr/bin/bash",
@@ -859,9 +1244,22 @@ func TestRpmDB_Package(t *testing.T) {
 					"rpmlib(PayloadIsZstd)",
 					"rtld(GNU_HASH)",
 				},
+				RequireFlags: []int32{
+					1280, 2304, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16777226, 16777226,
+					16777226, 16777226, 16384,
+				},
+				RequireVersions: []string{
+					"", "", "", "", "", "", "", "", "", "3.0.4-1", "4.6.0-1", "4.0-1", "5.4.18-1", "",
+				},
 			},
 			wantInstalledFiles:     Rockylinux9HostnameFiles,
 			wantInstalledFileNames: Rockylinux9HostnameFileNames,
+			wantChangelogLen:       7,
+			wantChangelog: ChangelogEntry{
+				Time: time.Date(2021, 8, 9, 12, 0, 0, 0, time.UTC),
+				Name: "Mohan Boddu <mboddu@redhat.com> - 3.23-6",
+				Text: "- Rebuilt for IMA sigs, glibc 2.34, aarch64 flags\n  Related: rhbz#1991688",
+			},
 		},
 		{
 			name:    "libuuid",
@@ -882,6 +1280,7 @@ func TestRpmDB_Package(t *testing.T) {
 				PGP:             "RSA/SHA256, Mon Apr  3 18:10:39 2023, Key ID 199e2f91fd431d51",
 				RSAHeader:       "RSA/SHA256, Mon Apr  3 18:10:39 2023, Key ID 199e2f91fd431d51",
 				InstallTime:     1696444673,
+				DBInstance:      1,
 				Provides: []string{
 					"libuuid",
 					"libuuid(x86-64)",
@@ -891,6 +1290,12 @@ func TestRpmDB_Package(t *testing.T) {
 					"libuuid.so.1(UUID_2.20)(64bit)",
 					"libuuid.so.1(UUID_2.31)(64bit)",
 				},
+				ProvideFlags: []int32{
+					8, 8, 32768, 32768, 32768, 32768, 32768,
+				},
+				ProvideVersions: []string{
+					"2.32.1-42.el8_8", "2.32.1-42.el8_8", "", "", "", "", "",
+				},
 				Requires: []string{
 					"/sbin/ldconfig",
 					"/sbin/ldconfig",
@@ -910,9 +1315,32 @@ func TestRpmDB_Package(t *testing.T) {
 					"rpmlib(PayloadIsXz)",
 					"rtld(GNU_HASH)",
 				},
+				RequireFlags: []int32{
+					1280, 4352, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384, 16384,
+					16777226, 16777226, 16777226, 16777226, 16384,
+				},
+				RequireVersions: []string{
+					"", "", "", "", "", "", "", "", "", "", "", "", "3.0.4-1", "4.6.0-1", "4.0-1",
+					"5.2-1", "",
+				},
+				Conflicts: []string{
+					"filesystem",
+				},
+				ConflictFlags: []int32{
+					2,
+				},
+				ConflictVersions: []string{
+					"3",
+				},
 			},
 			wantInstalledFiles:     LibuuidInstalledFiles,
 			wantInstalledFileNames: LibuuidInstalledFileNames,
+			wantChangelogLen:       570,
+			wantChangelog: ChangelogEntry{
+				Time: time.Date(2023, 3, 30, 12, 0, 0, 0, time.UTC),
+				Name: "Karel Zak <kzak@redhat.com> 2.32.1-42",
+				Text: "- fix #2180413 - Backport hint about systemd daemon-reload",
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -937,18 +1365,13 @@ func TestRpmDB_Package(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, tt.wantInstalledFileNames, gotInstalledFileNames)
 
-			// These fields are tested through InstalledFiles() above
-			got.BaseNames = nil
-			got.DirIndexes = nil
-			got.DirNames = nil
-			got.FileSizes = nil
-			got.FileDigests = nil
-			got.FileModes = nil
-			got.FileFlags = nil
-			got.UserNames = nil
-			got.GroupNames = nil
+			gotChangelog, err := got.Changelog()
+			assert.NoError(t, err)
+			if assert.Len(t, gotChangelog, tt.wantChangelogLen) {
+				assert.Equal(t, tt.wantChangelog, gotChangelog[0])
+			}
 
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, packageFields(got))
 
 			err = db.Close()
 			require.NoError(t, err)
@@ -956,6 +1379,58 @@ func TestRpmDB_Package(t *testing.T) {
 	}
 }
 
+// packageFields returns the package tags of p, leaving out the file tags that
+// are compared through InstalledFiles() and the changelog.
+func packageFields(p *PackageInfo) *PackageInfo {
+	return &PackageInfo{
+		Epoch:           p.Epoch,
+		Name:            p.Name,
+		Version:         p.Version,
+		Release:         p.Release,
+		Arch:            p.Arch,
+		SourceRpm:       p.SourceRpm,
+		Size:            p.Size,
+		License:         p.License,
+		Vendor:          p.Vendor,
+		Modularitylabel: p.Modularitylabel,
+		Summary:         p.Summary,
+		PGP:             p.PGP,
+		SigMD5:          p.SigMD5,
+		RSAHeader:       p.RSAHeader,
+		DigestAlgorithm: p.DigestAlgorithm,
+		InstallTime:     p.InstallTime,
+		DBInstance:      p.DBInstance,
+
+		VeritySignatureAlgo: p.VeritySignatureAlgo,
+
+		Provides:        p.Provides,
+		ProvideFlags:    p.ProvideFlags,
+		ProvideVersions: p.ProvideVersions,
+		Requires:        p.Requires,
+		RequireFlags:    p.RequireFlags,
+		RequireVersions: p.RequireVersions,
+
+		Conflicts:          p.Conflicts,
+		ConflictFlags:      p.ConflictFlags,
+		ConflictVersions:   p.ConflictVersions,
+		Obsoletes:          p.Obsoletes,
+		ObsoleteFlags:      p.ObsoleteFlags,
+		ObsoleteVersions:   p.ObsoleteVersions,
+		Recommends:         p.Recommends,
+		RecommendFlags:     p.RecommendFlags,
+		RecommendVersions:  p.RecommendVersions,
+		Suggests:           p.Suggests,
+		SuggestFlags:       p.SuggestFlags,
+		SuggestVersions:    p.SuggestVersions,
+		Supplements:        p.Supplements,
+		SupplementFlags:    p.SupplementFlags,
+		SupplementVersions: p.SupplementVersions,
+		Enhances:           p.Enhances,
+		EnhanceFlags:       p.EnhanceFlags,
+		EnhanceVersions:    p.EnhanceVersions,
+	}
+}
+
 func TestNevra(t *testing.T) {
 	blob, err := os.ReadFile("testdata/blob.bin")
 	indexEntries, err := headerImport(blob)
@@ -965,3 +1440,173 @@ func TestNevra(t *testing.T) {
 	_, err = pkg.InstalledFiles()
 	require.Error(t, err)
 }
+
+func TestPackageInfo_InstalledFiles_metadata(t *testing.T) {
+	db, err := Open("testdata/cbl-mariner-2.0/rpmdb.sqlite")
+	require.NoError(t, err)
+	defer db.Close()
+
+	pkg, err := db.Package("bash")
+	require.NoError(t, err)
+	files, err := pkg.InstalledFiles()
+	require.NoError(t, err)
+	require.Len(t, files, 67)
+
+	inodes := make(map[uint32]string)
+	for _, f := range files {
+		assert.Equal(t, int32(1), f.Device, f.Path)
+		assert.Equal(t, RPMVERIFY_ALL, f.VerifyFlags, f.Path)
+		assert.NotContains(t, inodes, f.Inode, f.Path)
+		inodes[f.Inode] = f.Path
+	}
+
+	bash := files[0]
+	assert.Equal(t, "/bin/bash", bash.Path)
+	assert.Equal(t, uint32(1643073599), bash.MTime)
+	assert.Equal(t, RPMFC_ELF64, bash.Color)
+	assert.Equal(t, "ELF 64-bit LSB pie executable, x86-64, version 1 (SYSV), dynamically linked, "+
+		"interpreter /lib64/ld-linux-x86-64.so.2, BuildID[sha1]=248a7367bb8f214573b78b5d47a0003d559b7a2f, "+
+		"for GNU/Linux 3.2.0, stripped", bash.Class)
+	assert.Empty(t, bash.Provides)
+	assert.Contains(t, bash.Requires, Dependency{Name: "libreadline.so.8()(64bit)", Flags: RPMSENSE_FIND_REQUIRES})
+
+	bashbug := files[1]
+	assert.Equal(t, "/bin/bashbug", bashbug.Path)
+	assert.Equal(t, int32(0), bashbug.Color)
+	assert.Equal(t, "POSIX shell script, ASCII text executable, with very long lines (318)", bashbug.Class)
+	assert.Equal(t, []Dependency{{Name: "/bin/sh", Flags: RPMSENSE_FIND_REQUIRES}}, bashbug.Requires)
+
+	sh := files[2]
+	assert.Equal(t, "/bin/sh", sh.Path)
+	assert.Equal(t, "bash", sh.LinkTo)
+	assert.Empty(t, sh.Class)
+}
+
+func TestPackageInfo_fileDependencies(t *testing.T) {
+	pkg := &PackageInfo{
+		Provides:     []string{"libfoo.so.1()(64bit)"},
+		Requires:     []string{"libc.so.6()(64bit)", "/bin/sh"},
+		FileDependsX: []int32{0, 0, 2, 3},
+		FileDependsN: []int32{2, 0, 3, 5},
+		DependsDict:  []int32{'P'<<24 | 0, 'R'<<24 | 0, 'R'<<24 | 1, 'X'<<24 | 0, 'R'<<24 | 7},
+	}
+	provideDeps := []Dependency{{Name: "libfoo.so.1()(64bit)"}}
+	requireDeps := []Dependency{{Name: "libc.so.6()(64bit)"}, {Name: "/bin/sh"}}
+
+	provides, requires := pkg.fileDependencies(0, provideDeps, requireDeps)
+	assert.Equal(t, []Dependency{{Name: "libfoo.so.1()(64bit)"}}, provides)
+	assert.Equal(t, []Dependency{{Name: "libc.so.6()(64bit)"}}, requires)
+
+	provides, requires = pkg.fileDependencies(1, provideDeps, requireDeps)
+	assert.Nil(t, provides)
+	assert.Nil(t, requires)
+
+	// entries of an unknown type or out of range are skipped
+	provides, requires = pkg.fileDependencies(2, provideDeps, requireDeps)
+	assert.Nil(t, provides)
+	assert.Equal(t, []Dependency{{Name: "/bin/sh"}}, requires)
+
+	// dependencies outside the dictionary
+	provides, requires = pkg.fileDependencies(3, provideDeps, requireDeps)
+	assert.Nil(t, provides)
+	assert.Nil(t, requires)
+}
+
+func TestPackageInfo_sizes(t *testing.T) {
+	int32s := func(values ...uint32) []byte {
+		var b []byte
+		for _, v := range values {
+			b = binary.BigEndian.AppendUint32(b, v)
+		}
+		return b
+	}
+	int64s := func(values ...uint64) []byte {
+		var b []byte
+		for _, v := range values {
+			b = binary.BigEndian.AppendUint64(b, v)
+		}
+		return b
+	}
+
+	tests := []struct {
+		name          string
+		sizeTags      []headerTag // must come first to stay aligned
+		wantSize      int64
+		wantFileSizes []int64
+	}{
+		{
+			name: "32-bit sizes over 2 GiB",
+			sizeTags: []headerTag{
+				{RPMTAG_SIZE, RPM_INT32_TYPE, int32s(3 << 30)},
+				{RPMTAG_FILESIZES, RPM_INT32_TYPE, int32s(3<<30, 1)},
+			},
+			wantSize:      3 << 30,
+			wantFileSizes: []int64{3 << 30, 1},
+		},
+		{
+			name: "64-bit sizes",
+			sizeTags: []headerTag{
+				{RPMTAG_LONGSIZE, RPM_INT64_TYPE, int64s(5 << 30)},
+				{RPMTAG_LONGFILESIZES, RPM_INT64_TYPE, int64s(5<<30, 1)},
+				{RPMTAG_SIZE, RPM_INT32_TYPE, int32s(1 << 30)},
+			},
+			wantSize:      5 << 30,
+			wantFileSizes: []int64{5 << 30, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blob := legacyHeader(append(tt.sizeTags,
+				headerTag{RPMTAG_DIRINDEXES, RPM_INT32_TYPE, int32s(0, 0)},
+				headerTag{RPMTAG_NAME, RPM_STRING_TYPE, []byte("model\x00")},
+				headerTag{RPMTAG_BASENAMES, RPM_STRING_ARRAY_TYPE, []byte("weights.bin\x00README\x00")},
+				headerTag{RPMTAG_DIRNAMES, RPM_STRING_ARRAY_TYPE, []byte("/usr/share/model/\x00")},
+			)...)
+			h, err := parseHeader(blob)
+			require.NoError(t, err)
+			pkg, err := h.PackageInfo()
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSize, pkg.Size)
+
+			files, err := pkg.InstalledFiles()
+			require.NoError(t, err)
+			var gotFileSizes []int64
+			for _, f := range files {
+				gotFileSizes = append(gotFileSizes, f.Size)
+			}
+			assert.Equal(t, tt.wantFileSizes, gotFileSizes)
+		})
+	}
+}
+
+func TestPackageInfo_InstalledFiles_unsignedMetadata(t *testing.T) {
+	int32s := func(values ...uint32) []byte {
+		var b []byte
+		for _, v := range values {
+			b = binary.BigEndian.AppendUint32(b, v)
+		}
+		return b
+	}
+
+	// an mtime after 2038 and an inode number over 2^31
+	blob := legacyHeader(
+		headerTag{RPMTAG_FILEMTIMES, RPM_INT32_TYPE, int32s(1<<31+1, 1643073599)},
+		headerTag{RPMTAG_FILEINODES, RPM_INT32_TYPE, int32s(1, 0xfffffffe)},
+		headerTag{RPMTAG_DIRINDEXES, RPM_INT32_TYPE, int32s(0, 0)},
+		headerTag{RPMTAG_NAME, RPM_STRING_TYPE, []byte("future\x00")},
+		headerTag{RPMTAG_BASENAMES, RPM_STRING_ARRAY_TYPE, []byte("a\x00b\x00")},
+		headerTag{RPMTAG_DIRNAMES, RPM_STRING_ARRAY_TYPE, []byte("/opt/\x00")},
+	)
+	h, err := parseHeader(blob)
+	require.NoError(t, err)
+	pkg, err := h.PackageInfo()
+	require.NoError(t, err)
+
+	files, err := pkg.InstalledFiles()
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.Equal(t, uint32(1<<31+1), files[0].MTime)
+	assert.Equal(t, uint32(1), files[0].Inode)
+	assert.Equal(t, uint32(1643073599), files[1].MTime)
+	assert.Equal(t, uint32(0xfffffffe), files[1].Inode)
+}
@@ -14,7 +14,7 @@ type commonPackageInfo struct {
 	Release         string
 	Arch            string
 	SourceRpm       string
-	Size            int
+	Size            int64
 	License         string
 	Vendor          string
 	Modularitylabel string
@@ -44,6 +44,25 @@ func toPackageInfo(pkgs []*commonPackageInfo) []*PackageInfo {
 	return pkgList
 }
 
+// commonFields returns the fields of p the package lists below describe. The
+// other fields are compared by TestRpmDB_Package and the tests of each tag.
+func commonFields(p *PackageInfo) *PackageInfo {
+	return toPackageInfo([]*commonPackageInfo{{
+		Epoch:           p.Epoch,
+		Name:            p.Name,
+		Version:         p.Version,
+		Release:         p.Release,
+		Arch:            p.Arch,
+		SourceRpm:       p.SourceRpm,
+		Size:            p.Size,
+		License:         p.License,
+		Vendor:          p.Vendor,
+		Modularitylabel: p.Modularitylabel,
+		Summary:         p.Summary,
+		SigMD5:          p.SigMD5,
+	}})[0]
+}
+
 var (
 	// docker run --rm -it centos:5 bash
 	// rpm -qa --queryformat "\{%{EPOCH}, \"%{NAME}\", \"%{VERSION}\", \"%{RELEASE}\", \"%{ARCH}\", \"%{SOURCERPM}\", %{SIZE}, \"%{LICENSE}\", \"%{VENDOR}\", \"\", \"%{SUMMARY}\", \"%{SIGMD5}\"\},\n" | sed "s/^{(none)/{intRef()/g" | sed -r 's/^\{([0-9]+),/{intRef(\1),/' | sed "s/(none)/0/g"
@@ -3660,409 +3679,409 @@ var (
 	}
 
 	CentOS5PythonInstalledFiles = []FileInfo{
-		{Path: "/usr/bin/pydoc", Mode: 0x81ed, Digest: "ba5dcf9eb37e464cec372640192d64ad", Size: 82, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/bin/python", Mode: 0x81ed, Digest: "43c97c09763ed41358120d0f87df1a99", Size: 4736, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/bin/python2", Mode: 0xa1ff, Digest: "", Size: 6, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/bin/python2.4", Mode: 0x81ed, Digest: "43c97c09763ed41358120d0f87df1a99", Size: 4736, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/doc/python-2.4.3", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/doc/python-2.4.3/LICENSE", Mode: 0x81a4, Digest: "0c5ffad47181d838261b710c0dfbdf9a", Size: 13487, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/python-2.4.3/README", Mode: 0x81a4, Digest: "3cb4a1f3f7b33acf3886c399979166db", Size: 50765, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/man/man1/python.1.gz", Mode: 0x81a4, Digest: "5a6410bfbd042581f41d9045caf829e3", Size: 5307, Username: "root", Groupname: "root", Flags: 2},
+		{Path: "/usr/bin/pydoc", Mode: 0x81ed, Digest: "ba5dcf9eb37e464cec372640192d64ad", Size: 82, Username: "root", Groupname: "root", Flags: 0, MTime: 1357732068, Inode: 61706073, Device: 64769, Class: "a python2.4 script text executable", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "/usr/bin/env", Flags: 16384}}},
+		{Path: "/usr/bin/python", Mode: 0x81ed, Digest: "43c97c09763ed41358120d0f87df1a99", Size: 4736, Username: "root", Groupname: "root", Flags: 0, MTime: 1357732088, Inode: 61702316, Device: 64769, Color: 2, Class: "ELF 64-bit LSB executable, AMD x86-64, version 1 (SYSV), for GNU/Linux 2.6.9, dynamically linked (uses shared libs), stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libdl.so.2()(64bit)", Flags: 16384}, {Name: "libm.so.6()(64bit)", Flags: 16384}, {Name: "libpthread.so.0()(64bit)", Flags: 16384}, {Name: "libpython2.4.so.1.0()(64bit)", Flags: 16384}, {Name: "libutil.so.1()(64bit)", Flags: 16384}, {Name: "rtld(GNU_HASH)", Flags: 16384}}},
+		{Path: "/usr/bin/python2", Mode: 0xa1ff, Digest: "", Size: 6, Username: "root", Groupname: "root", Flags: 0, MTime: 1357732068, LinkTo: "python", Inode: 61708153, Device: 64769, Class: "symbolic link to `python'", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/bin/python2.4", Mode: 0x81ed, Digest: "43c97c09763ed41358120d0f87df1a99", Size: 4736, Username: "root", Groupname: "root", Flags: 0, MTime: 1357732088, Inode: 61702316, Device: 64769, Color: 2, Class: "ELF 64-bit LSB executable, AMD x86-64, version 1 (SYSV), for GNU/Linux 2.6.9, dynamically linked (uses shared libs), stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libdl.so.2()(64bit)", Flags: 16384}, {Name: "libm.so.6()(64bit)", Flags: 16384}, {Name: "libpthread.so.0()(64bit)", Flags: 16384}, {Name: "libpython2.4.so.1.0()(64bit)", Flags: 16384}, {Name: "libutil.so.1()(64bit)", Flags: 16384}, {Name: "rtld(GNU_HASH)", Flags: 16384}}},
+		{Path: "/usr/share/doc/python-2.4.3", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0, MTime: 1357732101, Inode: 61736635, Device: 64769, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/python-2.4.3/LICENSE", Mode: 0x81a4, Digest: "0c5ffad47181d838261b710c0dfbdf9a", Size: 13487, Username: "root", Groupname: "root", Flags: 2, MTime: 1143080457, Inode: 61736636, Device: 64769, Class: "ASCII English text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/python-2.4.3/README", Mode: 0x81a4, Digest: "3cb4a1f3f7b33acf3886c399979166db", Size: 50765, Username: "root", Groupname: "root", Flags: 2, MTime: 1139671859, Inode: 61736637, Device: 64769, Class: "ASCII English text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/python.1.gz", Mode: 0x81a4, Digest: "5a6410bfbd042581f41d9045caf829e3", Size: 5307, Username: "root", Groupname: "root", Flags: 2, MTime: 1357731926, Inode: 61705560, Device: 64769, VerifyFlags: RPMVERIFY_ALL},
 	}
 
 	CentOS6GlibcInstalledFiles = []FileInfo{
-		{Path: "/etc/gai.conf", Mode: 0x81a4, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0, Username: "root", Groupname: "root", Flags: 25},
-		{Path: "/etc/ld.so.cache", Mode: 0x81a4, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0, Username: "root", Groupname: "root", Flags: 89},
-		{Path: "/etc/ld.so.conf", Mode: 0x81a4, Digest: "239c865e4c0746a01f82b03d38d620853bab2a2ba8e81d6f5606c503e0ea379f", Size: 28, Username: "root", Groupname: "root", Flags: 17},
-		{Path: "/etc/ld.so.conf.d", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/etc/localtime", Mode: 0xa1ff, Digest: "", Size: 29, Username: "root", Groupname: "root", Flags: 89},
-		{Path: "/etc/nsswitch.conf", Mode: 0x81a4, Digest: "e86e1d6d7941c53222aee80794345866d6534f757b1c28759430efdec905500a", Size: 1688, Username: "root", Groupname: "root", Flags: 17},
-		{Path: "/etc/rpc", Mode: 0x81a4, Digest: "990b547bc1bf0b1042d6ed34dba6ea8c512e8f0cd2bc322833f74371f7f6a6f9", Size: 1615, Username: "root", Groupname: "root", Flags: 17},
-		{Path: "/lib64/ld-2.12.so", Mode: 0x81ed, Digest: "5801d263321dd810fffbc89f51f16cd59233041df80b85ea633c44dae62c8fab", Size: 159312, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/ld-linux-x86-64.so.2", Mode: 0xa1ff, Digest: "", Size: 10, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libBrokenLocale-2.12.so", Mode: 0x81ed, Digest: "9fbd10ca830fd7dbe72dde5fb917ace196a946741f762046ad9fbe43acb2c220", Size: 8936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libBrokenLocale.so.1", Mode: 0xa1ff, Digest: "", Size: 23, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libSegFault.so", Mode: 0x81ed, Digest: "4004f025cb3bc66af2786606340caa82f82fefea0efd394ca526beb1ef5e2062", Size: 22384, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libanl-2.12.so", Mode: 0x81ed, Digest: "1fb24ef62b142a46339c28b2933b8cb6c505a4a6a34bdc74a0b3892c435acbb1", Size: 19872, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libanl.so.1", Mode: 0xa1ff, Digest: "", Size: 14, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libc-2.12.so", Mode: 0x81ed, Digest: "1c7f16207c1c58a5f8e0aaa93709ee2c18180f291a420ec2677b2fa745413b86", Size: 1924768, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libc.so.6", Mode: 0xa1ff, Digest: "", Size: 12, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libcidn-2.12.so", Mode: 0x81ed, Digest: "ba13a9012c9a823afd127b413dba8a29aa4da8c4bc972586c938493dbe82147a", Size: 201632, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libcidn.so.1", Mode: 0xa1ff, Digest: "", Size: 15, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libcrypt-2.12.so", Mode: 0x81ed, Digest: "a9c28f92c26cea64950f9bf6bdf43c78d7701099e7147d6a536b2b004c376eaf", Size: 40872, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libcrypt.so.1", Mode: 0xa1ff, Digest: "", Size: 16, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libdl-2.12.so", Mode: 0x81ed, Digest: "4edf32b1960f925ef3e70be0fcc04cc86ff0d7f691e346410a771e2d37f5290c", Size: 20024, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libdl.so.2", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libm-2.12.so", Mode: 0x81ed, Digest: "e4dc1e279bf38ea1c8ef1e9c32af12dc3bb88bee8efc6ee1bbd0f87d03716885", Size: 596864, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libm.so.6", Mode: 0xa1ff, Digest: "", Size: 12, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnsl-2.12.so", Mode: 0x81ed, Digest: "6427011bdb9c4f4751951b267e3c68922cabed988cb03142774c12b61855d34d", Size: 113904, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnsl.so.1", Mode: 0xa1ff, Digest: "", Size: 14, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_compat-2.12.so", Mode: 0x81ed, Digest: "e8ea7d360461591e9f7b9def227604ed2eece427bf2db42c5509e3e7f16a5c6a", Size: 43288, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_compat.so.2", Mode: 0xa1ff, Digest: "", Size: 21, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_dns-2.12.so", Mode: 0x81ed, Digest: "5f0db745d142aa4ef0e1f2e0feae08260ac6024748a26df6d1aaeebdfd1c8f9e", Size: 27896, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_dns.so.2", Mode: 0xa1ff, Digest: "", Size: 18, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_files-2.12.so", Mode: 0x81ed, Digest: "46346e35c392d5e22a9f4329210233df0861a20f40ecc6b5ca9e90255882d9ba", Size: 66432, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_files.so.2", Mode: 0xa1ff, Digest: "", Size: 20, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_hesiod-2.12.so", Mode: 0x81ed, Digest: "0e4b0d96abf6b094ec08121c2493b69b2dd0eda8bcb1fe7149c0690823521cde", Size: 24624, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_hesiod.so.2", Mode: 0xa1ff, Digest: "", Size: 21, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_nis-2.12.so", Mode: 0x81ed, Digest: "90e04e609d512d819a55ae435c236fc2eff8c2c87c69c60f99f981bcaa5ac738", Size: 53040, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_nis.so.2", Mode: 0xa1ff, Digest: "", Size: 18, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_nisplus-2.12.so", Mode: 0x81ed, Digest: "de10fd080fd9808b4beceb41d674aa47321ec6f02c2a3cd01702028ec1d75528", Size: 62184, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libnss_nisplus.so.2", Mode: 0xa1ff, Digest: "", Size: 22, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libpthread-2.12.so", Mode: 0x81ed, Digest: "54b3744fff87694a0b2b9ddc772c5806b638c003640202cd1cad264a5103a5cf", Size: 143280, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libpthread.so.0", Mode: 0xa1ff, Digest: "", Size: 18, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libresolv-2.12.so", Mode: 0x81ed, Digest: "890897d9703e15c08e272202ee9940eb59f5fdb8f252b5dc3cf91ecbc19ff2eb", Size: 111440, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libresolv.so.2", Mode: 0xa1ff, Digest: "", Size: 17, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/librt-2.12.so", Mode: 0x81ed, Digest: "cf6da056af3bef5de32618ca5f38f1295e69a6e0c6522c2feff7e3b5c182f7bf", Size: 44472, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/librt.so.1", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libthread_db-1.0.so", Mode: 0x81ed, Digest: "cfaa36f8a51e0cbf4a894cfa5ca1683eca312cbd64d42b917f17eea982c55060", Size: 34488, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libthread_db.so.1", Mode: 0xa1ff, Digest: "", Size: 19, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libutil-2.12.so", Mode: 0x81ed, Digest: "3aa80508ee35c3d255b4ba17f12931e01d0f9aac3698df02dc84a2178db52502", Size: 15056, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/libutil.so.1", Mode: 0xa1ff, Digest: "", Size: 15, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/rtkaio", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/rtkaio/librt.so.1", Mode: 0xa1ff, Digest: "", Size: 17, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/lib64/rtkaio/librtkaio-2.12.so", Mode: 0x81ed, Digest: "951b3377616277234a1462e27572171eb2069733722b2b9606cf335d8bd48346", Size: 53616, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/sbin/ldconfig", Mode: 0x81ed, Digest: "111e3902b6dec11574a24cfa666d23c9a75e74c62bf11de26654377082344d59", Size: 899192, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/sbin/sln", Mode: 0x81ed, Digest: "c741c4c888ccef43c2f90e08464f2e4657d150082b976f35b5527f34f78bb9a4", Size: 692840, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv", Mode: 0x41ed, Digest: "", Size: 12288, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ANSI_X3.110.so", Mode: 0x81ed, Digest: "992040064638dafed8945e441cf2c925d12e667fc9acaf54e124e7fca177bc5f", Size: 25464, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ARMSCII-8.so", Mode: 0x81ed, Digest: "7dc62f0563563361d78ac32694b5903d760b59345dfa55b5d7ad1b7467f78274", Size: 12944, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ASMO_449.so", Mode: 0x81ed, Digest: "7390c7ba183f9a2102aa5c04f826882db39aabbac79de91b37d6efec169f6d39", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/BIG5.so", Mode: 0x81ed, Digest: "146b7a7ccb12cf4a2e27566a5a4dce847f20010e7d981f0ff06379a323791e5b", Size: 91304, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/BIG5HKSCS.so", Mode: 0x81ed, Digest: "65d447e43e655a644515604b2c92121ffd15fede3231d7d6bacfc462008e2810", Size: 238256, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/BRF.so", Mode: 0x81ed, Digest: "ec1d6b1685048b677df6378ffa0e984e57d138ad2199e506a3e0cb03599056b3", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP10007.so", Mode: 0x81ed, Digest: "a96b8eca29b7b0b8484b9c0f28a2381fae020299e3ccf52a4258dd029d3196bb", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1125.so", Mode: 0x81ed, Digest: "5573c2ee993954c1a8d0853f745c1b21855fa8bbdbbbebc069a2de18f24bded7", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1250.so", Mode: 0x81ed, Digest: "5653bdd519861f0f05a9072969803a7ae58b6de60741b679826e378d4d50dfe3", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1251.so", Mode: 0x81ed, Digest: "d3e10311e66f7839663ce9888e8924db6dfaae919affb4af0b3b5a77d5a27c93", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1252.so", Mode: 0x81ed, Digest: "277d3822481d4533f7a983fe71676dce7ac025a1145c957ce4c2dbb834aa43ca", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1253.so", Mode: 0x81ed, Digest: "c63580cf22bff65ecf9072a2c9eb2832c90d3876d967e1ca67ec745fe1f7122c", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1254.so", Mode: 0x81ed, Digest: "faca2ff2b99aabaf0d696aa08bc3686d2a89e61fa85a76e750755682fe306409", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1255.so", Mode: 0x81ed, Digest: "338b7322d5aebe8e9d8a0876f2f2b2613180ddb6821ada8c9d59ae6c148a3ab0", Size: 17168, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1256.so", Mode: 0x81ed, Digest: "98bf7d67403c41b59c23a5cb63f213e7decc5ee3ca4bd0b0a093845d671fa003", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1257.so", Mode: 0x81ed, Digest: "1680a47946bf3808ce0434376ec9f0bf33e3f4af5445dd200b156d3938087a87", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP1258.so", Mode: 0x81ed, Digest: "e8b3e91c65dcbc5c54c8d583f3427171b5c2a8a03e2dcaa4c7381dc0c7a41588", Size: 17184, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP737.so", Mode: 0x81ed, Digest: "f6fecc63454898243bd114991d6f890c8b665f7442278a55118a67f632c14ae1", Size: 13224, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP775.so", Mode: 0x81ed, Digest: "574c40d9eef48f40034514f18f1481210296208ab030f2e2df39028f91ec340d", Size: 13248, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CP932.so", Mode: 0x81ed, Digest: "21e10c94b10e5e8930b390d5c72cc6bcfaba1527a3658f1fa604d71c21a493b9", Size: 99280, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CSN_369103.so", Mode: 0x81ed, Digest: "7bb7dd0446bca606171a852e0c2f15c06d0136825bd9f37bbe5a30c52445f4b9", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/CWI.so", Mode: 0x81ed, Digest: "eba40bdbbacb29dfcfdf28e0865fb54c1de0c9d6ba6e6aa9d747677514c30699", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/DEC-MCS.so", Mode: 0x81ed, Digest: "cb95724f9abbf14d053b1e8b913e172bbbdcdb4b57a14bff187ac4940be6b4e9", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-AT-DE-A.so", Mode: 0x81ed, Digest: "634f3a05f24553556c77d7ed86cce3d9404c37e432b3e9ab73e3b0fc14e3af0c", Size: 12936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-AT-DE.so", Mode: 0x81ed, Digest: "a96baef26e88533e517e0e043a29e9808e11420bec15c794856c7fb2ff8f9545", Size: 12936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-CA-FR.so", Mode: 0x81ed, Digest: "ae56e185d73e120782834247462e92dd7cc8b8ffce8d0c89580b688f67f7cafd", Size: 12936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-DK-NO-A.so", Mode: 0x81ed, Digest: "057ebb516a0549fb96d8dd3b9cf5ea547d871c52ed4639bc3dd88ef80174253e", Size: 12936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-DK-NO.so", Mode: 0x81ed, Digest: "0f07ec7632fdb6bac0767581c0db164348a65f4c3cb21f35c8906356f0753616", Size: 12936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-ES-A.so", Mode: 0x81ed, Digest: "37b83762526754a9c3ba1a1446fd7daac1c98c6f6b77cb8d30c74646ea2da7f0", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-ES-S.so", Mode: 0x81ed, Digest: "247aec091ff8cea3f34abaa5a56a1d8a255cfe18198b8655188d0aceb2648c50", Size: 12936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-ES.so", Mode: 0x81ed, Digest: "542fa1b1b7c19cfffabe77cae3e9fa6be727a49a837aeed2e0990a9f684fec95", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-FI-SE-A.so", Mode: 0x81ed, Digest: "5220d14db7feb86c460ac16286244e000afc64ac166adf999389f9cc8c35a67a", Size: 12936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-FI-SE.so", Mode: 0x81ed, Digest: "25ca67407e0f7abf98b603dd87e568fbb9c1b6c6cde730f32d2999e4651fdaa5", Size: 12936, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-FR.so", Mode: 0x81ed, Digest: "dd7baf45053b567de38cc985046e876111f09b29282f93454d2885e4e71d1a8b", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-IS-FRISS.so", Mode: 0x81ed, Digest: "4b719eb6dbd58760b59cb30feb924f8800c3805b297f01c09570387f0aad74b1", Size: 12976, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-IT.so", Mode: 0x81ed, Digest: "958b23145c8e97de5cb5b43165091633a6508f77ddf8d849ecb1a93b0f299f25", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-PT.so", Mode: 0x81ed, Digest: "1a80a6659cf8df1315fb600288f4c8ba52cc1dc1ef75b02b08466397d4256f8b", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-UK.so", Mode: 0x81ed, Digest: "55d379a230ddbae7dd5be337958b65f23fe9c17b152e37b5ba856e2b81e0c93f", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EBCDIC-US.so", Mode: 0x81ed, Digest: "74a8e0b607ec48835ff5b6691806925bf50e235d2cf36c5cbc7c9bb0d451af92", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ECMA-CYRILLIC.so", Mode: 0x81ed, Digest: "87280bf714578f7ee7ae931d4457d327ce438fd1e63d84dba88fb264bc818226", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EUC-CN.so", Mode: 0x81ed, Digest: "cf0e798fa10f1866f48810c4b54ef9d0ab0285663f9deb48f492f0d0b2200320", Size: 17424, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EUC-JISX0213.so", Mode: 0x81ed, Digest: "3367511f74cb96ffe94ca4432a668a628f73279090dc3e4466abc8f1d108a089", Size: 17256, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EUC-JP-MS.so", Mode: 0x81ed, Digest: "24c8a2e1add46e6e07d05bf3ff94531435b7b995fea5ef6776cc6c3c5e311dbd", Size: 87032, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EUC-JP.so", Mode: 0x81ed, Digest: "d6b2d70ea33a80db73f0e9cc2fbff1575b816244a78727bebde8c9b66bb95b61", Size: 17416, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EUC-KR.so", Mode: 0x81ed, Digest: "b9e006366263fcc3096c00e3d94a4eb3c2f4ce3faf33da418ef9ee85ee9ccda2", Size: 13088, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/EUC-TW.so", Mode: 0x81ed, Digest: "25f5bf41bfe18d1633475c03861b84c07495ded4e270733ca87d574a92e3f8e4", Size: 26424, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GB18030.so", Mode: 0x81ed, Digest: "d0c7d23d78b09291c01ec003fe0764a36e60b1b5075e697c18639e68d120eb46", Size: 185064, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GBBIG5.so", Mode: 0x81ed, Digest: "1fe9eaffe72dfddc7900ab5877a7eed10be6f627ee4af997528042fa4df41f1f", Size: 57968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GBGBK.so", Mode: 0x81ed, Digest: "033dc5034a3ff23c3413624e0413e8015408cb3a21029a72947e6ac09624bdaa", Size: 12816, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GBK.so", Mode: 0x81ed, Digest: "d79f116d58aea8c5df541623adcc8e6811f5ae283eb1392946319e1307d93266", Size: 115784, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GEORGIAN-ACADEMY.so", Mode: 0x81ed, Digest: "c2f3cfd87f5fa5fbde3fe20b7f6186dcb1c74c3eabd59e0b8275f91be988d689", Size: 12976, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GEORGIAN-PS.so", Mode: 0x81ed, Digest: "484a36b7a05637efa06f6775c9c8ddfed3a15a6bbabd8079c49a25b9cd0e1210", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GOST_19768-74.so", Mode: 0x81ed, Digest: "b5a9cace944419f5820950cf26ca6f509e5e7da58af110474f9825ea91a96396", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GREEK-CCITT.so", Mode: 0x81ed, Digest: "14a7fb15f4419f3bdee2c681f075124003f827590bb7b2d5ae26cd60ac4d4f36", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GREEK7-OLD.so", Mode: 0x81ed, Digest: "70b8ca5220d415feb253520c226e43cda0cb319d1b1057d1192ddb836d41e21c", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/GREEK7.so", Mode: 0x81ed, Digest: "a8943672394fb71f7de6fee62993dfad1c96e74b29385e19bf6c3e7bc73bc2c6", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/HP-GREEK8.so", Mode: 0x81ed, Digest: "e771ff71b9a54067824f09c3603d6108ed78ee750c14eb74641aed4b654dd976", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/HP-ROMAN8.so", Mode: 0x81ed, Digest: "32c8e4474b042405a537fa2b30f07b6b2abad7cb2398735baceed24416ce303c", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/HP-ROMAN9.so", Mode: 0x81ed, Digest: "e900ff11c922b3123f3664aabf631c214f7da428d46c9efb069c11cec8e9ff27", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/HP-THAI8.so", Mode: 0x81ed, Digest: "786301b5e375b262dab6d5e8dccf296defeeb53ae86f53998feb9e3b77dd5357", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/HP-TURKISH8.so", Mode: 0x81ed, Digest: "445058b3fb9ab2f8b7c25dac1267d3d4826870e8909665d28f27bedadd5fbb35", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM037.so", Mode: 0x81ed, Digest: "425cfb98c889c268373afc5bee6e01631852731fe15d9778ed7628842b05d992", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM038.so", Mode: 0x81ed, Digest: "3aca8582c85265063718a45b4ac6dfddda1ccfae5b4c30dee16311865e39151c", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1004.so", Mode: 0x81ed, Digest: "43992ca4d2cfb4bd3b9cb4fca07571b79e1fb4eefaff97db880264df39347a4a", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1008.so", Mode: 0x81ed, Digest: "3b49768acd1985cfe2e76be8fa22d6cdd56c08fbccce906d4d0ee59c3f92ba04", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1008_420.so", Mode: 0x81ed, Digest: "d6bbbbe54f83d86c306b8c2092342aea5fc56b82fcd88b52e2e8d0e3dfec3070", Size: 8832, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1025.so", Mode: 0x81ed, Digest: "f3c0e550cba622ef91b8a53102c5e4270c601673a0e54868bebce4cbf4a0b0e1", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1026.so", Mode: 0x81ed, Digest: "cc0184d5d65a555fee6b2c41c483c67330b110f6ed0bcc1ac650913f63db3a13", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1046.so", Mode: 0x81ed, Digest: "10a711755299fd7dff764acada6e364c32f12e4f73db01ad74cb247df1c521ec", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1047.so", Mode: 0x81ed, Digest: "52e4c9937da94d4aa599db2233df63879a1de7e7e212c696d109dc5454e14324", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1097.so", Mode: 0x81ed, Digest: "82b3616fe06b95b5a1d3f8dc1b2dca7d0b1beb0a8d3b73f68ac591e6abd3d457", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1112.so", Mode: 0x81ed, Digest: "6018d7ebe00e71a0784d6862d818cbaa4b48e274600ba30bd6493dc84479ebd6", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1122.so", Mode: 0x81ed, Digest: "c0faa948eb9584ac6b7ea44124e3980e37bb8256d2363f34186a36fee1187903", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1123.so", Mode: 0x81ed, Digest: "7b951fb124b3dad91ef6796715735ec492789f473cc386fdad22c5ec72c9eb27", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1124.so", Mode: 0x81ed, Digest: "efdd56158d1ad11d152f92fe3642d1239db58592ed8e89f9ca7aa443de741333", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1129.so", Mode: 0x81ed, Digest: "c40628c4672899313ef256ab1b9b65a037d2a3065ef57a049bee38f39a54c5a3", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1130.so", Mode: 0x81ed, Digest: "c04f69b5a23d4ea56a17465a1bef21b3bf7e85cd6d414652f802914f00ca08f5", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1132.so", Mode: 0x81ed, Digest: "5b8483c7b724ee0e976d40f7ac5ad288c5db60a295845741d34bca82c54332ae", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1133.so", Mode: 0x81ed, Digest: "204539c9917c49dcd4eafb3a2a9e50d8a25eb49f737c934bf5b2fa24753136be", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1137.so", Mode: 0x81ed, Digest: "cc23a512c915cbc9310b60ae63535eb327eb7c3835a124e1a7c21fc86ec76109", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1140.so", Mode: 0x81ed, Digest: "186fe8305040be8011b767dabd9d31b418f2099b96b6ec05329863d3d7b23ee5", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1141.so", Mode: 0x81ed, Digest: "53bb28773a26f735c76ec165e44be23dec8cb3adffe01b5ea0d27f1a2ffe3f4a", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1142.so", Mode: 0x81ed, Digest: "a9b84e13f5fc5e62e7c13a714efa90f72f8861eb13fe6f43d1ee8c65b96c1084", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1143.so", Mode: 0x81ed, Digest: "1063d45e72751c4fd3b9fa664df207c33c2a1a0663b6670063a136c02cb0ad71", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1144.so", Mode: 0x81ed, Digest: "f6af55bcdb776bee236ce28cedfa285b52b0b91a6e7e1659dd3e47ef8e0922bb", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1145.so", Mode: 0x81ed, Digest: "a5ba79f319f8838f4f7df383fffaaa68bc92bd85c9ef9b539ccf32dd2314b8f6", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1146.so", Mode: 0x81ed, Digest: "d3e38b5b995fcd653a2a5f898ed6e599c062a05d9dee248838a622533a23a982", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1147.so", Mode: 0x81ed, Digest: "8ef03c4cdb54b359415856dc0c0316a1c642090bd4601a0cd703cac368e59e88", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1148.so", Mode: 0x81ed, Digest: "ea4aff633ee245e1a2db9c31dcbc9d16afeaf79b75164963ae9316f4d555f764", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1149.so", Mode: 0x81ed, Digest: "348b8c947876ead5581452264ce8604eed0897966d65869b904dcba44f9fca54", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1153.so", Mode: 0x81ed, Digest: "0bf8e00624c78b6ff3ab224467769b91949f2460408c5aa071020d30d84858e8", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1154.so", Mode: 0x81ed, Digest: "4bdbda5dbbc19e94801c2d6222a7571a16db4e423df7c10f1482bf1f3945bf5a", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1155.so", Mode: 0x81ed, Digest: "5b9fdbccf79d1c2de443b77fc288d3422aa8271508ce216ecc0ff4f98bac11b8", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1156.so", Mode: 0x81ed, Digest: "db9bcc97b8cff1f0135e5f3ef24a176b6567418ec6b566ef4f0d23e9ba1d3e6d", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1157.so", Mode: 0x81ed, Digest: "bb166d507b85022dcb6443cf64b99ad925af7abfbbc6f51513917205e58dfcc6", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1158.so", Mode: 0x81ed, Digest: "e431c36d8d9c9a4d446b9bd55a2a184604b0f5704c30d7ad4852bf11f7c40e80", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1160.so", Mode: 0x81ed, Digest: "be64038146a91f2e00f47978a71db7cd7b100b779cf1a9a90e6ea247478b4f21", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1161.so", Mode: 0x81ed, Digest: "7984ab267971c7a4f915add42406c7ff9c5d688ed696a9c66067448000a8379f", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1162.so", Mode: 0x81ed, Digest: "2a83ff0bfb676ed8c782ce444df6b15226043e9702054c2ea2a24d9de5570b97", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1163.so", Mode: 0x81ed, Digest: "9b4e36dbcb7ac1a3a4ff15caabcf05a8dd33833f61b04964a1f30bf5e31c7f53", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1164.so", Mode: 0x81ed, Digest: "dcabaa9bf3da292d2cb6ad8757e404bbfce1f1f13288a721da441ed3bb6d2a7c", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1166.so", Mode: 0x81ed, Digest: "66b516cf29c0fbbae9191e06bd6bb25e8a9a58673e63376cf310b97559d9aa70", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1167.so", Mode: 0x81ed, Digest: "8525cdad406c2e0c549c5d9f133208406e31419bf5f6f9157e6497c4e3623a70", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM12712.so", Mode: 0x81ed, Digest: "643576aef3e0b66fa45e8a489abfb0150760a4e49c15615c7ac2345ffedbbb7e", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1364.so", Mode: 0x81ed, Digest: "6dde92b7e4a7fdbe575c78a03fdb83794df8e9320abb8fdd7f9eeecb045be5cb", Size: 152408, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1371.so", Mode: 0x81ed, Digest: "8427ccba754f7cca355ec077dde8ce72cdbfdcb2e3c1e1d7223f29d8d5bb963b", Size: 127832, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1388.so", Mode: 0x81ed, Digest: "884199a6b3fa141bd8b94265703035f5e3f6502008262c16f485d3278d012b1a", Size: 172888, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1390.so", Mode: 0x81ed, Digest: "534ca78d2edfd037db714d8a239bbcb39da8cf16b75b62f596c94d1c42388b80", Size: 230336, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM1399.so", Mode: 0x81ed, Digest: "a82165f90de1e0a17256dd69ebe4ed06894798acd0d31b82ad776e12bbe5eb44", Size: 230336, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM16804.so", Mode: 0x81ed, Digest: "43c8d40e2b0bab213802f180a0af912ee63fd2edf6e5536c6808d90b09cc2f6d", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM256.so", Mode: 0x81ed, Digest: "9e20014decc14566834d77a1405e5a1ed9ad271b458c7af2c4df01894eb7ab7c", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM273.so", Mode: 0x81ed, Digest: "a1b169f9ddd7d47553a0f6cee28e6fdf18ac4f5dc5a1b9895ed3fad739e91c78", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM274.so", Mode: 0x81ed, Digest: "53a314fc378368913c87de2e8c47c567146748bf9093109379c07b8eaf7c3709", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM275.so", Mode: 0x81ed, Digest: "a4a9b2d0707aefa4554b7546efb0d961af6730d44cb75df70470a3fd4ff25ee4", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM277.so", Mode: 0x81ed, Digest: "5db16f5a7e8f62adacffd1380257b51c3a6c3773ac9a4b0a0177e6465f18c2a0", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM278.so", Mode: 0x81ed, Digest: "a4ec72a8882cae30d4b76052aaef0cfc6d5a5e2c23651d940d02dbc3a079607c", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM280.so", Mode: 0x81ed, Digest: "db0d8c15f27daaf8b752691427e21e66dbc016897ff9d8dde830547c4b02ac9f", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM281.so", Mode: 0x81ed, Digest: "453e38c625370c63085d0ead3fb6090416c13c43eb419269c8a1193ff420165b", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM284.so", Mode: 0x81ed, Digest: "5e1711bf7ca5c630da1fc48e516a4327b663b29bd02d6d7b9c545ac0c25df0ad", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM285.so", Mode: 0x81ed, Digest: "7d07f890be8fd1c0e81ed93cbd6e1c786e3628b4474974c3fce54408604f3eee", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM290.so", Mode: 0x81ed, Digest: "71222daa6b904a3de3085af13a9914f4bc13db150fa5be5928c06b9c3d7d0448", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM297.so", Mode: 0x81ed, Digest: "e1f83ff9d83028a035430bafffc41d22f1113b07d57361e69692f12d226d85c5", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM420.so", Mode: 0x81ed, Digest: "652ed558ecccdb463bc3b575bd79e1b06cc6bccab04b5d40c44d047574e32c40", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM423.so", Mode: 0x81ed, Digest: "467915473b431902f24145cbfe0612ef924f3c5f70cc0bd30dae432406bacb51", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM424.so", Mode: 0x81ed, Digest: "347f38466c512d069d48aecca495a2ccdb4275dd1e2bd66c7de0993ea6ad93e3", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM437.so", Mode: 0x81ed, Digest: "66459200279e8f1ac2ce9d607eff83a04086dca85f15adc4a2539321ee8db2f7", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM4517.so", Mode: 0x81ed, Digest: "e5e6b724a0e5926c312d325b8ef0cc69a09b50c87b03359b1668aecd4a24aa8c", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM4899.so", Mode: 0x81ed, Digest: "ebdffa371b29d24276cf186d5c46b527c6c2044dce5cca563cfc3b445bb4ff44", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM4909.so", Mode: 0x81ed, Digest: "c62376159e5cda2f24b41938e7bfb41c0172e87b6512aff164b086b4637b0e40", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM4971.so", Mode: 0x81ed, Digest: "8948e0297c9f304499acb6c9f1771100bc42d148c43da51b002dfce042fc2916", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM500.so", Mode: 0x81ed, Digest: "f6ef759e605a3caf647594c20a85b1320126ec8a82bf5e19ca3f7a3efa4e6809", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM5347.so", Mode: 0x81ed, Digest: "66c3957d3205ee50937ef999723b9111c43a80fd007125ecf6f5998b5dfc27cb", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM803.so", Mode: 0x81ed, Digest: "c30646f442d3a91a3f1653c0de9fee1a5082316eb2d30ae05caf895a4f8ce1b2", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM850.so", Mode: 0x81ed, Digest: "582e37871f0e561c6f2aa0c19cd6c71ab5fcb305cbcf75f598d09c8ccfeada6c", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM851.so", Mode: 0x81ed, Digest: "817dfc538b05a9d68a192816f920fb25e3e958afa3db624ee0f249a9df931074", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM852.so", Mode: 0x81ed, Digest: "fbb4757ac5ef44330b973491711fa0402af065a96b559026a9b1a558355bcbbd", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM855.so", Mode: 0x81ed, Digest: "e64bd0c29d66dc6d970408f259bfcc30a46b526033a2c405db039ae31f9d2144", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM856.so", Mode: 0x81ed, Digest: "ca536d16ed76de3fc7ec756e6b4a7741864b2e5ded7cc78fe431dec9f9aa68cb", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM857.so", Mode: 0x81ed, Digest: "414258a6c41cace42dc502f05555e0c837994619a557da1f92358245012c94bd", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM860.so", Mode: 0x81ed, Digest: "c5f96217983e7d8a686145fb24f48e708e35b586d967c0198c8a4ac3aa8356bb", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM861.so", Mode: 0x81ed, Digest: "9a3e5292ecdac5020bfdb7c1f5c94912af865f1020334d8d8bf0895ba3276978", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM862.so", Mode: 0x81ed, Digest: "56e7dc94a966c36ee7dcc0acea0fdae2c29e3628fed9434909c10c1bd6325c71", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM863.so", Mode: 0x81ed, Digest: "eda3501dbaca3c5664cd4c638455350cdbf53544f942db318e43801bf276d32e", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM864.so", Mode: 0x81ed, Digest: "61e561884ca05e2ce3b116adc3b07573d99fbc3805ee61db51923356ef4e8f51", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM865.so", Mode: 0x81ed, Digest: "f9c10f386c08409fd56820c7f923126f89d48423602502651baf786fd30cdfdd", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM866.so", Mode: 0x81ed, Digest: "58dbf7ed066c6a6443b929647db41a9364ac3325d5156d0a3d3d2d5eec59a03a", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM866NAV.so", Mode: 0x81ed, Digest: "10d7efe3ad25e4d2e9354f07e0ba4aed7b0b5d33ce862cd0a47a9ea9065046b0", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM868.so", Mode: 0x81ed, Digest: "fd2fddf0e49182fb20d9346465431afef951cc2100b4e88a36d60f97d1e8551b", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM869.so", Mode: 0x81ed, Digest: "f815721dbc62432a2859e7dcfff94eb48c74a81a95cf99ed2a387748126acc3e", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM870.so", Mode: 0x81ed, Digest: "c7370be8e0cdc6ae1d5411d543888e3e926fc5cf007d4212b0ab55f6b18b6fc9", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM871.so", Mode: 0x81ed, Digest: "c49d504a3389553cfd32b62ddb4f5615c6d3d042e9ee53a2f49255f92b75a204", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM874.so", Mode: 0x81ed, Digest: "ee18f36056ccdc3ea0ea51170b7b13cc4b3d177ad03ff0f87fb8faeaf1815a0c", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM875.so", Mode: 0x81ed, Digest: "c3819c91502c3fe5a97bddd8b24cc1591c86af22b45592b0632887cd27255cdc", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM880.so", Mode: 0x81ed, Digest: "db40834acd51008dfb6401f02f4a605be5bb3c2ba276d7f2b194e5a789f91d05", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM891.so", Mode: 0x81ed, Digest: "efea7096e7f631c8802ef8524f6989a2a19fcd4eed21c03afa4d4dfe349f7a91", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM901.so", Mode: 0x81ed, Digest: "524c7644ba6ef81246c00c43eb293d35e65974ca475ec6223e100f2d6dbe2a83", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM902.so", Mode: 0x81ed, Digest: "a95583091f5d6b4faecba4d438f69b2a6f56873bf952510527d42c0ae5e80f3a", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM903.so", Mode: 0x81ed, Digest: "ab1005eef92d77c337e4fade4df20e46bfd652e723e509f23fcf59cdbf401c3f", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM9030.so", Mode: 0x81ed, Digest: "602800323179c22541d81e0fcd4a9c087c9163f8d54bc4cb8bbd5946f2a19ea8", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM904.so", Mode: 0x81ed, Digest: "e9553a1bcc614fed1c588ba17e7d7268dd882df4e5b43f8af90f60f747bb0c73", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM905.so", Mode: 0x81ed, Digest: "6675d58a2186807342842f4dc8c34003f8100f3d909276e1ca4d8f59d2f9c335", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM9066.so", Mode: 0x81ed, Digest: "e53025857073d03318b256aaf3fb6701f2ccafa86b23e7a64af5499c5a2ae1db", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM918.so", Mode: 0x81ed, Digest: "dc4530d17ccb16db0fc95f0a72d7b62586dc58f9d3613073fa2746f1b7fee8d9", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM921.so", Mode: 0x81ed, Digest: "01d7f9d67c172a8215c87b9a0ab33e50fa0fcc78e4cbf59099bfb1185ba90fe1", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM922.so", Mode: 0x81ed, Digest: "dd81e29d1fbb15ba3b2e27c3cd2cecca2836e89e6e9979a17ff47c455a859845", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM930.so", Mode: 0x81ed, Digest: "b9a0eb5c7a44351e3ec991f465a614840339660f6346c5874380399f15a705e4", Size: 107344, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM932.so", Mode: 0x81ed, Digest: "7cd334612653e34c25cc11a793d446f9744447d7465f9aadac71afdc4fe75158", Size: 70464, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM933.so", Mode: 0x81ed, Digest: "60a36caa21eec08a70af57799f53dcbdda8154ccd455b6a231bdf6dae8562317", Size: 127824, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM935.so", Mode: 0x81ed, Digest: "859e645a585f3b2300cf9c541451394ed87ed5a115afa0132915f19a37a42c30", Size: 95056, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM937.so", Mode: 0x81ed, Digest: "b606fb90bdc098198390dea0d4c4b61b96fc005c3a06e1f5188ac748438bcdb6", Size: 115536, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM939.so", Mode: 0x81ed, Digest: "e65c7203ddea0f9be74999df1c80087ea64b8f2aaa5b228600aed6fd443956c3", Size: 107344, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM943.so", Mode: 0x81ed, Digest: "651026e2945739d24343c68c8fb4825b1c972cd62139198574457847be7f7f99", Size: 70464, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IBM9448.so", Mode: 0x81ed, Digest: "562033b7733a9da270fc3dcb63742798efd7ae2eff37876e5f53703431453806", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/IEC_P27-1.so", Mode: 0x81ed, Digest: "ed3c73c1b322a56fd16ba0f59f67621801179d08b25d300550d15c7be10f651c", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/INIS-8.so", Mode: 0x81ed, Digest: "2bb9fcf9e07d668cd730eba6f39a8f30910e38f431d246094f618903b36880de", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/INIS-CYRILLIC.so", Mode: 0x81ed, Digest: "5a63beb032390f4bdeff271e0685635106e73e082d7ffc6a3e51136050bdd7f7", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/INIS.so", Mode: 0x81ed, Digest: "2f70b714ca058b863236c952d45346c97d65499cf921eb6beade6533aeda1860", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISIRI-3342.so", Mode: 0x81ed, Digest: "55549d34b791ab9c1b5ff7e5bd1eaeb6449c50d4cae015287fc8617e9a7486dc", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO-2022-CN-EXT.so", Mode: 0x81ed, Digest: "233e18a5023aa322aefa3ba30eca3dab207d36c52d7c26e1e9c0fca438e306ad", Size: 47488, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO-2022-CN.so", Mode: 0x81ed, Digest: "a34beadeabbb7ff9c2608bb59175162b1c27f24a6f2d721264e750d8f35219e1", Size: 34664, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO-2022-JP-3.so", Mode: 0x81ed, Digest: "95d8505170dc3ed0194108658ccd565816c4a24510d7fbdaf76df4db2225c266", Size: 25696, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO-2022-JP.so", Mode: 0x81ed, Digest: "8a66e38b3e2ca397701511f05a3b43ff36b51ad4dec14189c303579c75462d43", Size: 43080, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO-2022-KR.so", Mode: 0x81ed, Digest: "fc56396c6fe5ece00c86491fde33ac978e8a5c86f2d167f4a8d3d69e5c1548ab", Size: 17168, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO-IR-197.so", Mode: 0x81ed, Digest: "dcbd420049c2fcb02d5496861a6f195b26aa94263e331f2e82957897bb361e1c", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO-IR-209.so", Mode: 0x81ed, Digest: "776a0f104862ab9cdbceab290e1bfe20dda86548ebc5fe338fed3394aa70a82c", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO646.so", Mode: 0x81ed, Digest: "e158d39fb07417594261d46f1b04bb7873a8d0f94aa615b8d3a278d3d4eb0381", Size: 29424, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-1.so", Mode: 0x81ed, Digest: "968ce054a2b284d2b80c18d87d560869c215f2e58984d9edd0dc426c1b6693a0", Size: 12864, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-10.so", Mode: 0x81ed, Digest: "4f99ab6758ca01cd6c1a982439e9c3463a7db05a6f4c6a6c29924a0cd9474efb", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-11.so", Mode: 0x81ed, Digest: "25d87660e0b6223423e5a8e480f677f9fd36739afec428773c6b0919a3753517", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-13.so", Mode: 0x81ed, Digest: "c0d5bb8abc2369663190e5cf33b168188c399883ab967370cd9b1dce41b8543e", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-14.so", Mode: 0x81ed, Digest: "fd5abc392ac4a2e008a82718a2678f2f63aec733dcd5760cc0c45e2e1edd3472", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-15.so", Mode: 0x81ed, Digest: "079b2dfe86d2e4ad7b8b447dc1413f4ea09363a1bb7cc5d6e504f97a54f1a29b", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-16.so", Mode: 0x81ed, Digest: "3258073881081b32c4ff3d423f3b9b88c41f963c7fc7911fb2d8819267396741", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-2.so", Mode: 0x81ed, Digest: "c9ec4ca3666de17a0935abc96984b5519c714082dc8b2d3433fc74071cfbc55d", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-3.so", Mode: 0x81ed, Digest: "2761782840c784b52cd88247ebed268723f60b75d39e3688fa3da7319677d708", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-4.so", Mode: 0x81ed, Digest: "4b52d71d5735ddd7b36699591656aeb522749d0fac03bd8fad66b6cb57875911", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-5.so", Mode: 0x81ed, Digest: "caa9d83d16a6ae7bcc09492457b40401b472d1e43f62aa0d8babc936bee920f6", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-6.so", Mode: 0x81ed, Digest: "0a2b787fe59d9c46db27a8c79b85c95d7fd59d8c302a39b607c559d779abf22a", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-7.so", Mode: 0x81ed, Digest: "80a387435ca8b52f775a4f50d38643cb45fc7a8623351d96413c01ef2903b293", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-8.so", Mode: 0x81ed, Digest: "3cc7b590c220df601961dddf543811a0b0fd5c4c9b42a3b2c878985c1e03fbf0", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-9.so", Mode: 0x81ed, Digest: "fa01dcd538bf4a73b8f4ee3c18dbaba6e3d48b9d5ac397756bea8279191fd0cd", Size: 12928, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO8859-9E.so", Mode: 0x81ed, Digest: "538379737c13e3b5bfaedd36bf361a1cf9cac0e4bd3b8ec8cb12b8ee3e2ce6a8", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO_10367-BOX.so", Mode: 0x81ed, Digest: "2fa45a5407a0baed9f340e81d49a3af887cba6187b32f7889df1dcb13f366721", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO_11548-1.so", Mode: 0x81ed, Digest: "f9fc05588f8cf6cb87108d09ac2dedea4001c85725937867d54fdce0b9c78322", Size: 12872, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO_2033.so", Mode: 0x81ed, Digest: "b615c2a0b3754ab22e26647f23d3bf8cf715e09ab6b448b0db5ee93a45beed72", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO_5427-EXT.so", Mode: 0x81ed, Digest: "bb178689f4fbc6386035e4a07044aa935a427c366fbd3d455bff1d0478b8b342", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO_5427.so", Mode: 0x81ed, Digest: "b5848cf336f2c5f2f4aff2163d4702ab5aa0070f20ebb5bb8da64d55c6a2314f", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO_5428.so", Mode: 0x81ed, Digest: "12262d118494ad6e9c73c37b6127dcfeeb8a456f76c4cd2f1d4abf319d2a05a8", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO_6937-2.so", Mode: 0x81ed, Digest: "827221fe4800c76fe93de4b740a6c32166543f34dff466c720cf5dabd963f62c", Size: 21224, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/ISO_6937.so", Mode: 0x81ed, Digest: "f2db0c236632d3935758cd971a84981e103f1ca78c78466f4c3a87d7e3b5082e", Size: 25320, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/JOHAB.so", Mode: 0x81ed, Digest: "b32a2ab97f86f448d18d6382b1f2dd0f7e22b39fdb9be66dc278efab10fd8b77", Size: 17448, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/KOI-8.so", Mode: 0x81ed, Digest: "f7c2285643cf5a88f9c254d69737359970183e6e8654f659b60a086fa429c338", Size: 12920, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/KOI8-R.so", Mode: 0x81ed, Digest: "ab9d2abe4a0a5d5cd24ff48c055cbae39bdd5ed6e670dc29e20dbd5c77a675c0", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/KOI8-RU.so", Mode: 0x81ed, Digest: "404b0886fbfd342b045f7910e47190e1f20485472a3e453bb6a2136160da7964", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/KOI8-T.so", Mode: 0x81ed, Digest: "0dac37cd0ce494f3536174ac50395e9cb0cd7da98782a4a4e3ef44d5ec715dd5", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/KOI8-U.so", Mode: 0x81ed, Digest: "a8c038e01d28901f229d133a7d1dcccda933eb8d9164b5261afa2d0849cbc740", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/LATIN-GREEK-1.so", Mode: 0x81ed, Digest: "f087836226ad927459559461054dd761a977e94e04812069fa3ae7d32c3e17a2", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/LATIN-GREEK.so", Mode: 0x81ed, Digest: "14fc03ff22fee470dfd217126fd02e87e6d8188c73559c04197d6919988d3d03", Size: 12968, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/MAC-CENTRALEUROPE.so", Mode: 0x81ed, Digest: "e9a4bf52d8525390ce68b5864bb6ba1d9a49297eaa3b1b71527ece549cbb07d7", Size: 12976, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/MAC-IS.so", Mode: 0x81ed, Digest: "93bb4ddfb8a74c1e6f315d4dec5ceb0028050e084b0595ab9c0cd04bb17b4c90", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/MAC-SAMI.so", Mode: 0x81ed, Digest: "17caa423bb02429d1118e8419e4287cf38b07c968be890ee1af900cccf4c640a", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/MAC-UK.so", Mode: 0x81ed, Digest: "19dc5563f0528392697f6fa20743ef2eb7469e615bcf63cce8dd8fa08aab62c0", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/MACINTOSH.so", Mode: 0x81ed, Digest: "7fe9811d921f9d33931f0fbbf2c7fe4ee7884f08167ab514efa7a1d824bf34f0", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/MIK.so", Mode: 0x81ed, Digest: "1755c53332dc5eecfc6fe957f5edb5079ffd3b3a7e61b5d9d96f9841110987e1", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/NATS-DANO.so", Mode: 0x81ed, Digest: "89c3ee6c5a5282b968049b5cb12cc98c9c89899746f9797fe4e341d9e3546d2b", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/NATS-SEFI.so", Mode: 0x81ed, Digest: "022d837a738a37b74e3efab3c2563bb28442b9098b91bc709ddc6b9e69a52f35", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/PT154.so", Mode: 0x81ed, Digest: "00b432e9fb6736c990aaccccaecb211aab3acb0d671d88bcc7008b220895d6cb", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/RK1048.so", Mode: 0x81ed, Digest: "01a5f2e5c6f61cfcf1155278a22eacc10113e6a42d3507d15f32790afc558539", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/SAMI-WS2.so", Mode: 0x81ed, Digest: "421d015c53f05140da80170e12be6231006a090549aa039470960b9216ca1acc", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/SHIFT_JISX0213.so", Mode: 0x81ed, Digest: "5e1792695e6af92932768d2ff788a0ffc20512d8e622d0da29fdfa606dc44dbc", Size: 17264, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/SJIS.so", Mode: 0x81ed, Digest: "07b9a79ceb2160ad9d36c816ee3dd95dc4056d779bc4b923d6cb3ca6c3460a8b", Size: 95072, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/T.61.so", Mode: 0x81ed, Digest: "90ccfefe08a9c813d65fb75b25da1735b75c4ec780ab31d84923a3cf61c7f825", Size: 21216, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/TCVN5712-1.so", Mode: 0x81ed, Digest: "d0a2b63df0846a77277c27264d55cf5421d5e23d6d00d692514ea325e22e46b1", Size: 17240, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/TIS-620.so", Mode: 0x81ed, Digest: "e2f4ef81de5e7edaf8b75d3e0458d976f6ba17466bbc897541f11c941084ba42", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/TSCII.so", Mode: 0x81ed, Digest: "5a188ed2be198af3f8ce939bf546cc824319a882bafc310fc51717ce090dbef9", Size: 21256, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/UHC.so", Mode: 0x81ed, Digest: "486dfa345d12e83ae83595ca20d1899fc91f57cdb455da4fdad2a420d3a16c1b", Size: 74616, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/UNICODE.so", Mode: 0x81ed, Digest: "a934e46295f94147b9a70ab07b3e9c97730cc92e99606d34c036dac807a8ec4b", Size: 12960, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/UTF-16.so", Mode: 0x81ed, Digest: "249e5e492a813de719a51fcf92f4776f35aac93407de143c725ae7c09db6b0db", Size: 13016, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/UTF-32.so", Mode: 0x81ed, Digest: "690df77765c61dfc55f27b3f3d124923dd6e2d707e37fd751311c7800e0a648b", Size: 13016, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/UTF-7.so", Mode: 0x81ed, Digest: "97d9709f586804ea4743cd82e159ffd3e9d9cdd8e87dd3eae092c15a3402c4c5", Size: 21200, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/VISCII.so", Mode: 0x81ed, Digest: "cc4284e8b380fc4db4c96c69432fe9eb52d3b12f7f7b421a9c50766e1a023de2", Size: 12952, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/gconv-modules", Mode: 0x81a4, Digest: "caa9c4b59d20b59f1430cb0d8a40100ce96251b2594129e8253ae10aad7019ae", Size: 56103, Username: "root", Groupname: "root", Flags: 17},
-		{Path: "/usr/lib64/gconv/gconv-modules.cache", Mode: 0x81a4, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/libCNS.so", Mode: 0x81ed, Digest: "fda992879a3ed74e8a18d3b41922465903acac3e72ff8e27b166b67075056e3a", Size: 472600, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/libGB.so", Mode: 0x81ed, Digest: "0c65e1ffa767b92f43a2d2043e0e2fd60d5d491c76733c374e7a5cf046d28646", Size: 70344, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/libISOIR165.so", Mode: 0x81ed, Digest: "5884d9ab2010f025826259ac2fb3ee826c17eb0dda58d9545880a792f7c00966", Size: 61824, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/libJIS.so", Mode: 0x81ed, Digest: "fb592d1dd7624dfdf76da191fdeb382c4da6c61ec53f506eb40b174dfe38ed61", Size: 103168, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/libJISX0213.so", Mode: 0x81ed, Digest: "56c3c442aa81192a78996ec64b378141fb0da42ff05fdcbbea3afb4ae9db15cc", Size: 123384, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/gconv/libKSC.so", Mode: 0x81ed, Digest: "16207e9305df9960417e127ac99c9dcdb66eaf8b1d1948461d4658d7be916c86", Size: 49632, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/libmemusage.so", Mode: 0x81ed, Digest: "2430154ed9e5af1995446e43f009787f40b3fc8a00c61c53779d9c6d47a2c2ed", Size: 23808, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/libpcprofile.so", Mode: 0x81ed, Digest: "017eeba9d6a4e77ebf61dc6d3df85f4ee5479c592b69762d492a51c786f70933", Size: 9272, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/libexec/getconf", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/libexec/getconf/POSIX_V6_LP64_OFF64", Mode: 0x81ed, Digest: "bad7760cfdca61b76cae487e050e063c92725084c87917d467900fe5e9ed7a72", Size: 22808, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/libexec/getconf/POSIX_V7_LP64_OFF64", Mode: 0x81ed, Digest: "bad7760cfdca61b76cae487e050e063c92725084c87917d467900fe5e9ed7a72", Size: 22808, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/sbin/glibc_post_upgrade.x86_64", Mode: 0x81c0, Digest: "31449eff6ea6cb37ce03099141f27f71aa992089970aa94a7b359ab363a8c129", Size: 692856, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/sbin/iconvconfig", Mode: 0x81ed, Digest: "7b3ad7c4db6e9419b197c90b3b7596961e1e0b0613d2ce4169ca8e6098d19e3e", Size: 23056, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/sbin/iconvconfig.x86_64", Mode: 0x81ed, Digest: "7b3ad7c4db6e9419b197c90b3b7596961e1e0b0613d2ce4169ca8e6098d19e3e", Size: 23056, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/doc/glibc-2.12", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/doc/glibc-2.12/BUGS", Mode: 0x81a4, Digest: "9b2d4b25c8600508e1d148feeaed5da04a13daf988d5854012aebcc37fd84ef6", Size: 2954, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/CONFORMANCE", Mode: 0x81a4, Digest: "64753d94e291cb24b0dceec2e32bd6a0bba35b8d74a6d64d9ea345705088dc88", Size: 6598, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/COPYING", Mode: 0x81a4, Digest: "204d8eff92f95aac4df6c8122bc1505f468f3a901e5a4cc08940e0ede1938994", Size: 18009, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/COPYING.LIB", Mode: 0x81a4, Digest: "ca0061fc1381a3ab242310e4b3f56389f28e3d460eb2fd822ed7a21c6f030532", Size: 26536, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/FAQ", Mode: 0x81a4, Digest: "21705edf8c77e38140afe51b3e0b762afcf464b0c210fe51a85a789191e3c7ef", Size: 84483, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/INSTALL", Mode: 0x81a4, Digest: "7905f20dc8bf7a0e0d375ee3b70c67f2eba7c52a3436ae8f16b1732abc3dc13b", Size: 22140, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/LICENSES", Mode: 0x81a4, Digest: "291c84cdb0ece86692c555bb6b61d6229c95c1d83c14751360ace8de17ad5b79", Size: 10728, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/NEWS", Mode: 0x81a4, Digest: "5bd87874e54b96440f697edcd1cb37cab75b48393605241cb994819bfc324f23", Size: 72289, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/NOTES", Mode: 0x81a4, Digest: "179b5fe54f02281b21f133cc8757db265af2b403b8978641e7ad6bf8f1ca9e13", Size: 10702, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/PROJECTS", Mode: 0x81a4, Digest: "b3ea3fbdc20ac7e3477fccb2a3e7e81d4f7e429644379a91455c39b785202a52", Size: 4155, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/README", Mode: 0x81a4, Digest: "7ea3e20637ecd9ddbc5ca7b23d41003437cf3dd50e309b95aeb239018582fb2b", Size: 4838, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/README.hesiod", Mode: 0x81a4, Digest: "71b88fbf6a491615b8370677f6cf49d518aa5d85bfba3da36252bc0e43939729", Size: 5672, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/glibc-2.12/README.libm", Mode: 0x81a4, Digest: "b4f55a722fcfa05d23ebea15705084d1c307617458414dc758bcf7ffdb009703", Size: 31517, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/var/cache/ldconfig", Mode: 0x41c0, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/var/cache/ldconfig/aux-cache", Mode: 0x8180, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0, Username: "root", Groupname: "root", Flags: 89},
+		{Path: "/etc/gai.conf", Mode: 0x81a4, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0, Username: "root", Groupname: "root", Flags: 25, MTime: 1529414194, Inode: 1, Device: 1, Class: "empty", VerifyFlags: 0xffffffdc},
+		{Path: "/etc/ld.so.cache", Mode: 0x81a4, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0, Username: "root", Groupname: "root", Flags: 89, MTime: 1529414194, Inode: 2, Device: 1, Class: "empty", VerifyFlags: 0xffffffd8},
+		{Path: "/etc/ld.so.conf", Mode: 0x81a4, Digest: "239c865e4c0746a01f82b03d38d620853bab2a2ba8e81d6f5606c503e0ea379f", Size: 28, Username: "root", Groupname: "root", Flags: 17, MTime: 1374691825, Inode: 3, Device: 1, Class: "ASCII text", VerifyFlags: 0xffffffdc},
+		{Path: "/etc/ld.so.conf.d", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414194, Inode: 4, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/etc/localtime", Mode: 0xa1ff, Digest: "", Size: 29, Username: "root", Groupname: "root", Flags: 89, MTime: 1529414158, LinkTo: "../usr/share/zoneinfo/Factory", Inode: 5, Device: 1, Class: "broken symbolic link to `../usr/share/zoneinfo/Factory'", VerifyFlags: 0xffffffd8},
+		{Path: "/etc/nsswitch.conf", Mode: 0x81a4, Digest: "e86e1d6d7941c53222aee80794345866d6534f757b1c28759430efdec905500a", Size: 1688, Username: "root", Groupname: "root", Flags: 17, MTime: 1273000941, Inode: 6, Device: 1, Class: "ASCII text", VerifyFlags: 0xffffffdc},
+		{Path: "/etc/rpc", Mode: 0x81a4, Digest: "990b547bc1bf0b1042d6ed34dba6ea8c512e8f0cd2bc322833f74371f7f6a6f9", Size: 1615, Username: "root", Groupname: "root", Flags: 17, MTime: 1272972443, Inode: 7, Device: 1, Class: "ASCII text", VerifyFlags: 0xffffffdc},
+		{Path: "/lib64/ld-2.12.so", Mode: 0x81ed, Digest: "5801d263321dd810fffbc89f51f16cd59233041df80b85ea633c44dae62c8fab", Size: 159312, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 9, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 32768}, {Name: "ld-linux-x86-64.so.2(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "ld-linux-x86-64.so.2(GLIBC_2.3)(64bit)", Flags: 32768}, {Name: "ld-linux-x86-64.so.2(GLIBC_2.4)(64bit)", Flags: 32768}}},
+		{Path: "/lib64/ld-linux-x86-64.so.2", Mode: 0xa1ff, Digest: "", Size: 10, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "ld-2.12.so", Inode: 10, Device: 1, Class: "symbolic link to `ld-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libBrokenLocale-2.12.so", Mode: 0x81ed, Digest: "9fbd10ca830fd7dbe72dde5fb917ace196a946741f762046ad9fbe43acb2c220", Size: 8936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 11, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libBrokenLocale.so.1()(64bit)", Flags: 32768}, {Name: "libBrokenLocale.so.1(GLIBC_2.2.5)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libBrokenLocale.so.1", Mode: 0xa1ff, Digest: "", Size: 23, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libBrokenLocale-2.12.so", Inode: 12, Device: 1, Class: "symbolic link to `libBrokenLocale-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libBrokenLocale.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libSegFault.so", Mode: 0x81ed, Digest: "4004f025cb3bc66af2786606340caa82f82fefea0efd394ca526beb1ef5e2062", Size: 22384, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 13, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libSegFault.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.3)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libanl-2.12.so", Mode: 0x81ed, Digest: "1fb24ef62b142a46339c28b2933b8cb6c505a4a6a34bdc74a0b3892c435acbb1", Size: 19872, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 14, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libanl.so.1()(64bit)", Flags: 32768}, {Name: "libanl.so.1(GLIBC_2.2.5)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.2)(64bit)", Flags: 16384}, {Name: "libpthread.so.0()(64bit)", Flags: 16384}, {Name: "libpthread.so.0(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libanl.so.1", Mode: 0xa1ff, Digest: "", Size: 14, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libanl-2.12.so", Inode: 15, Device: 1, Class: "symbolic link to `libanl-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libanl.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libc-2.12.so", Mode: 0x81ed, Digest: "1c7f16207c1c58a5f8e0aaa93709ee2c18180f291a420ec2677b2fa745413b86", Size: 1924768, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414860, Inode: 16, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (GNU/Linux), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.10)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.11)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.12)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.2.6)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.3.2)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.3.3)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.3.4)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.4)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.5)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.6)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.7)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.8)(64bit)", Flags: 32768}, {Name: "libc.so.6(GLIBC_2.9)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "ld-linux-x86-64.so.2(GLIBC_2.3)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libc.so.6", Mode: 0xa1ff, Digest: "", Size: 12, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libc-2.12.so", Inode: 17, Device: 1, Class: "symbolic link to `libc-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libcidn-2.12.so", Mode: 0x81ed, Digest: "ba13a9012c9a823afd127b413dba8a29aa4da8c4bc972586c938493dbe82147a", Size: 201632, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 18, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libcidn.so.1()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libcidn.so.1", Mode: 0xa1ff, Digest: "", Size: 15, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libcidn-2.12.so", Inode: 19, Device: 1, Class: "symbolic link to `libcidn-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libcidn.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libcrypt-2.12.so", Mode: 0x81ed, Digest: "a9c28f92c26cea64950f9bf6bdf43c78d7701099e7147d6a536b2b004c376eaf", Size: 40872, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 20, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libcrypt.so.1()(64bit)", Flags: 32768}, {Name: "libcrypt.so.1(GLIBC_2.2.5)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libfreebl3.so()(64bit)", Flags: 16384}, {Name: "libfreebl3.so(NSSRAWHASH_3.12.3)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libcrypt.so.1", Mode: 0xa1ff, Digest: "", Size: 16, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libcrypt-2.12.so", Inode: 21, Device: 1, Class: "symbolic link to `libcrypt-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libcrypt.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libdl-2.12.so", Mode: 0x81ed, Digest: "4edf32b1960f925ef3e70be0fcc04cc86ff0d7f691e346410a771e2d37f5290c", Size: 20024, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414860, Inode: 22, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libdl.so.2()(64bit)", Flags: 32768}, {Name: "libdl.so.2(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "libdl.so.2(GLIBC_2.3.3)(64bit)", Flags: 32768}, {Name: "libdl.so.2(GLIBC_2.3.4)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libdl.so.2", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libdl-2.12.so", Inode: 23, Device: 1, Class: "symbolic link to `libdl-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libdl.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libm-2.12.so", Mode: 0x81ed, Digest: "e4dc1e279bf38ea1c8ef1e9c32af12dc3bb88bee8efc6ee1bbd0f87d03716885", Size: 596864, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 24, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (GNU/Linux), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libm.so.6()(64bit)", Flags: 32768}, {Name: "libm.so.6(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "libm.so.6(GLIBC_2.4)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libm.so.6", Mode: 0xa1ff, Digest: "", Size: 12, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libm-2.12.so", Inode: 25, Device: 1, Class: "symbolic link to `libm-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libm.so.6()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnsl-2.12.so", Mode: 0x81ed, Digest: "6427011bdb9c4f4751951b267e3c68922cabed988cb03142774c12b61855d34d", Size: 113904, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 26, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libnsl.so.1()(64bit)", Flags: 32768}, {Name: "libnsl.so.1(GLIBC_2.2.5)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnsl.so.1", Mode: 0xa1ff, Digest: "", Size: 14, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libnsl-2.12.so", Inode: 27, Device: 1, Class: "symbolic link to `libnsl-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libnsl.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_compat-2.12.so", Mode: 0x81ed, Digest: "e8ea7d360461591e9f7b9def227604ed2eece427bf2db42c5509e3e7f16a5c6a", Size: 43288, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 28, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libnss_compat.so.2()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libnsl.so.1()(64bit)", Flags: 16384}, {Name: "libnsl.so.1(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_compat.so.2", Mode: 0xa1ff, Digest: "", Size: 21, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libnss_compat-2.12.so", Inode: 29, Device: 1, Class: "symbolic link to `libnss_compat-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libnss_compat.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_dns-2.12.so", Mode: 0x81ed, Digest: "5f0db745d142aa4ef0e1f2e0feae08260ac6024748a26df6d1aaeebdfd1c8f9e", Size: 27896, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 30, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libnss_dns.so.2()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libresolv.so.2()(64bit)", Flags: 16384}, {Name: "libresolv.so.2(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_dns.so.2", Mode: 0xa1ff, Digest: "", Size: 18, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libnss_dns-2.12.so", Inode: 31, Device: 1, Class: "symbolic link to `libnss_dns-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libnss_dns.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_files-2.12.so", Mode: 0x81ed, Digest: "46346e35c392d5e22a9f4329210233df0861a20f40ecc6b5ca9e90255882d9ba", Size: 66432, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 32, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libnss_files.so.2()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_files.so.2", Mode: 0xa1ff, Digest: "", Size: 20, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libnss_files-2.12.so", Inode: 33, Device: 1, Class: "symbolic link to `libnss_files-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libnss_files.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_hesiod-2.12.so", Mode: 0x81ed, Digest: "0e4b0d96abf6b094ec08121c2493b69b2dd0eda8bcb1fe7149c0690823521cde", Size: 24624, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 34, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libnss_hesiod.so.2()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libnss_files.so.2()(64bit)", Flags: 16384}, {Name: "libresolv.so.2()(64bit)", Flags: 16384}, {Name: "libresolv.so.2(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libresolv.so.2(GLIBC_2.9)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_hesiod.so.2", Mode: 0xa1ff, Digest: "", Size: 21, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libnss_hesiod-2.12.so", Inode: 35, Device: 1, Class: "symbolic link to `libnss_hesiod-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libnss_hesiod.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_nis-2.12.so", Mode: 0x81ed, Digest: "90e04e609d512d819a55ae435c236fc2eff8c2c87c69c60f99f981bcaa5ac738", Size: 53040, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414860, Inode: 36, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libnss_nis.so.2()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libnsl.so.1()(64bit)", Flags: 16384}, {Name: "libnsl.so.1(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libnss_files.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_nis.so.2", Mode: 0xa1ff, Digest: "", Size: 18, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libnss_nis-2.12.so", Inode: 37, Device: 1, Class: "symbolic link to `libnss_nis-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libnss_nis.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_nisplus-2.12.so", Mode: 0x81ed, Digest: "de10fd080fd9808b4beceb41d674aa47321ec6f02c2a3cd01702028ec1d75528", Size: 62184, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 38, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libnss_nisplus.so.2()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libnsl.so.1()(64bit)", Flags: 16384}, {Name: "libnsl.so.1(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libnss_nisplus.so.2", Mode: 0xa1ff, Digest: "", Size: 22, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libnss_nisplus-2.12.so", Inode: 39, Device: 1, Class: "symbolic link to `libnss_nisplus-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libnss_nisplus.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libpthread-2.12.so", Mode: 0x81ed, Digest: "54b3744fff87694a0b2b9ddc772c5806b638c003640202cd1cad264a5103a5cf", Size: 143280, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 40, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libpthread.so.0()(64bit)", Flags: 32768}, {Name: "libpthread.so.0(GLIBC_2.11)(64bit)", Flags: 32768}, {Name: "libpthread.so.0(GLIBC_2.12)(64bit)", Flags: 32768}, {Name: "libpthread.so.0(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "libpthread.so.0(GLIBC_2.2.6)(64bit)", Flags: 32768}, {Name: "libpthread.so.0(GLIBC_2.3.2)(64bit)", Flags: 32768}, {Name: "libpthread.so.0(GLIBC_2.3.3)(64bit)", Flags: 32768}, {Name: "libpthread.so.0(GLIBC_2.3.4)(64bit)", Flags: 32768}, {Name: "libpthread.so.0(GLIBC_2.4)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "ld-linux-x86-64.so.2(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "ld-linux-x86-64.so.2(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.2)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libpthread.so.0", Mode: 0xa1ff, Digest: "", Size: 18, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libpthread-2.12.so", Inode: 41, Device: 1, Class: "symbolic link to `libpthread-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libpthread.so.0()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libresolv-2.12.so", Mode: 0x81ed, Digest: "890897d9703e15c08e272202ee9940eb59f5fdb8f252b5dc3cf91ecbc19ff2eb", Size: 111440, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 42, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libresolv.so.2()(64bit)", Flags: 32768}, {Name: "libresolv.so.2(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "libresolv.so.2(GLIBC_2.3.2)(64bit)", Flags: 32768}, {Name: "libresolv.so.2(GLIBC_2.9)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.4)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libresolv.so.2", Mode: 0xa1ff, Digest: "", Size: 17, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libresolv-2.12.so", Inode: 43, Device: 1, Class: "symbolic link to `libresolv-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libresolv.so.2()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/librt-2.12.so", Mode: 0x81ed, Digest: "cf6da056af3bef5de32618ca5f38f1295e69a6e0c6522c2feff7e3b5c182f7bf", Size: 44472, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414858, Inode: 44, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "librt.so.1()(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.3.3)(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.3.4)(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.4)(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.7)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.2)(64bit)", Flags: 16384}, {Name: "libpthread.so.0()(64bit)", Flags: 16384}, {Name: "libpthread.so.0(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/librt.so.1", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414194, LinkTo: "librt-2.12.so", Inode: 45, Device: 1, Class: "symbolic link to `librt-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "librt.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libthread_db-1.0.so", Mode: 0x81ed, Digest: "cfaa36f8a51e0cbf4a894cfa5ca1683eca312cbd64d42b917f17eea982c55060", Size: 34488, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414859, Inode: 46, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libthread_db.so.1()(64bit)", Flags: 32768}, {Name: "libthread_db.so.1(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "libthread_db.so.1(GLIBC_2.3)(64bit)", Flags: 32768}, {Name: "libthread_db.so.1(GLIBC_2.3.3)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libthread_db.so.1", Mode: 0xa1ff, Digest: "", Size: 19, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libthread_db-1.0.so", Inode: 47, Device: 1, Class: "symbolic link to `libthread_db-1.0.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libthread_db.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libutil-2.12.so", Mode: 0x81ed, Digest: "3aa80508ee35c3d255b4ba17f12931e01d0f9aac3698df02dc84a2178db52502", Size: 15056, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414860, Inode: 48, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libutil.so.1()(64bit)", Flags: 32768}, {Name: "libutil.so.1(GLIBC_2.2.5)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/lib64/libutil.so.1", Mode: 0xa1ff, Digest: "", Size: 15, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414173, LinkTo: "libutil-2.12.so", Inode: 49, Device: 1, Class: "symbolic link to `libutil-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libutil.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/rtkaio", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414860, Inode: 50, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/lib64/rtkaio/librt.so.1", Mode: 0xa1ff, Digest: "", Size: 17, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414194, LinkTo: "librtkaio-2.12.so", Inode: 51, Device: 1, Class: "symbolic link to `librtkaio-2.12.so'", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "librt.so.1()(64bit)", Flags: 16384}}},
+		{Path: "/lib64/rtkaio/librtkaio-2.12.so", Mode: 0x81ed, Digest: "951b3377616277234a1462e27572171eb2069733722b2b9606cf335d8bd48346", Size: 53616, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414860, Inode: 52, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "librt.so.1()(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.2.5)(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.3.3)(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.3.4)(64bit)", Flags: 32768}, {Name: "librt.so.1(GLIBC_2.4)(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.2)(64bit)", Flags: 16384}, {Name: "libpthread.so.0()(64bit)", Flags: 16384}, {Name: "libpthread.so.0(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/sbin/ldconfig", Mode: 0x81ed, Digest: "111e3902b6dec11574a24cfa666d23c9a75e74c62bf11de26654377082344d59", Size: 899192, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 53, Device: 1, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (GNU/Linux), statically linked, for GNU/Linux 2.6.18, stripped", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/sbin/sln", Mode: 0x81ed, Digest: "c741c4c888ccef43c2f90e08464f2e4657d150082b976f35b5527f34f78bb9a4", Size: 692840, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 54, Device: 1, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (GNU/Linux), statically linked, for GNU/Linux 2.6.18, stripped", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib64/gconv", Mode: 0x41ed, Digest: "", Size: 12288, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 55, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib64/gconv/ANSI_X3.110.so", Mode: 0x81ed, Digest: "992040064638dafed8945e441cf2c925d12e667fc9acaf54e124e7fca177bc5f", Size: 25464, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 56, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ANSI_X3.110.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ARMSCII-8.so", Mode: 0x81ed, Digest: "7dc62f0563563361d78ac32694b5903d760b59345dfa55b5d7ad1b7467f78274", Size: 12944, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 57, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ARMSCII-8.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ASMO_449.so", Mode: 0x81ed, Digest: "7390c7ba183f9a2102aa5c04f826882db39aabbac79de91b37d6efec169f6d39", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 58, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ASMO_449.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/BIG5.so", Mode: 0x81ed, Digest: "146b7a7ccb12cf4a2e27566a5a4dce847f20010e7d981f0ff06379a323791e5b", Size: 91304, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 59, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "BIG5.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/BIG5HKSCS.so", Mode: 0x81ed, Digest: "65d447e43e655a644515604b2c92121ffd15fede3231d7d6bacfc462008e2810", Size: 238256, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 60, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "BIG5HKSCS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/BRF.so", Mode: 0x81ed, Digest: "ec1d6b1685048b677df6378ffa0e984e57d138ad2199e506a3e0cb03599056b3", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 61, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "BRF.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP10007.so", Mode: 0x81ed, Digest: "a96b8eca29b7b0b8484b9c0f28a2381fae020299e3ccf52a4258dd029d3196bb", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 62, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP10007.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1125.so", Mode: 0x81ed, Digest: "5573c2ee993954c1a8d0853f745c1b21855fa8bbdbbbebc069a2de18f24bded7", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 63, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1125.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1250.so", Mode: 0x81ed, Digest: "5653bdd519861f0f05a9072969803a7ae58b6de60741b679826e378d4d50dfe3", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 64, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1250.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1251.so", Mode: 0x81ed, Digest: "d3e10311e66f7839663ce9888e8924db6dfaae919affb4af0b3b5a77d5a27c93", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 65, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1251.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1252.so", Mode: 0x81ed, Digest: "277d3822481d4533f7a983fe71676dce7ac025a1145c957ce4c2dbb834aa43ca", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 66, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1252.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1253.so", Mode: 0x81ed, Digest: "c63580cf22bff65ecf9072a2c9eb2832c90d3876d967e1ca67ec745fe1f7122c", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 67, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1253.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1254.so", Mode: 0x81ed, Digest: "faca2ff2b99aabaf0d696aa08bc3686d2a89e61fa85a76e750755682fe306409", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 68, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1254.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1255.so", Mode: 0x81ed, Digest: "338b7322d5aebe8e9d8a0876f2f2b2613180ddb6821ada8c9d59ae6c148a3ab0", Size: 17168, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 69, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1255.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1256.so", Mode: 0x81ed, Digest: "98bf7d67403c41b59c23a5cb63f213e7decc5ee3ca4bd0b0a093845d671fa003", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 70, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1256.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1257.so", Mode: 0x81ed, Digest: "1680a47946bf3808ce0434376ec9f0bf33e3f4af5445dd200b156d3938087a87", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 71, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1257.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP1258.so", Mode: 0x81ed, Digest: "e8b3e91c65dcbc5c54c8d583f3427171b5c2a8a03e2dcaa4c7381dc0c7a41588", Size: 17184, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 72, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP1258.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP737.so", Mode: 0x81ed, Digest: "f6fecc63454898243bd114991d6f890c8b665f7442278a55118a67f632c14ae1", Size: 13224, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 73, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP737.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP775.so", Mode: 0x81ed, Digest: "574c40d9eef48f40034514f18f1481210296208ab030f2e2df39028f91ec340d", Size: 13248, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 74, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP775.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CP932.so", Mode: 0x81ed, Digest: "21e10c94b10e5e8930b390d5c72cc6bcfaba1527a3658f1fa604d71c21a493b9", Size: 99280, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 75, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CP932.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CSN_369103.so", Mode: 0x81ed, Digest: "7bb7dd0446bca606171a852e0c2f15c06d0136825bd9f37bbe5a30c52445f4b9", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 76, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CSN_369103.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/CWI.so", Mode: 0x81ed, Digest: "eba40bdbbacb29dfcfdf28e0865fb54c1de0c9d6ba6e6aa9d747677514c30699", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 77, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "CWI.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/DEC-MCS.so", Mode: 0x81ed, Digest: "cb95724f9abbf14d053b1e8b913e172bbbdcdb4b57a14bff187ac4940be6b4e9", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 78, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "DEC-MCS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-AT-DE-A.so", Mode: 0x81ed, Digest: "634f3a05f24553556c77d7ed86cce3d9404c37e432b3e9ab73e3b0fc14e3af0c", Size: 12936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 79, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-AT-DE-A.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-AT-DE.so", Mode: 0x81ed, Digest: "a96baef26e88533e517e0e043a29e9808e11420bec15c794856c7fb2ff8f9545", Size: 12936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 80, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-AT-DE.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-CA-FR.so", Mode: 0x81ed, Digest: "ae56e185d73e120782834247462e92dd7cc8b8ffce8d0c89580b688f67f7cafd", Size: 12936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 81, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-CA-FR.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-DK-NO-A.so", Mode: 0x81ed, Digest: "057ebb516a0549fb96d8dd3b9cf5ea547d871c52ed4639bc3dd88ef80174253e", Size: 12936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 82, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-DK-NO-A.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-DK-NO.so", Mode: 0x81ed, Digest: "0f07ec7632fdb6bac0767581c0db164348a65f4c3cb21f35c8906356f0753616", Size: 12936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 83, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-DK-NO.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-ES-A.so", Mode: 0x81ed, Digest: "37b83762526754a9c3ba1a1446fd7daac1c98c6f6b77cb8d30c74646ea2da7f0", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 84, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-ES-A.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-ES-S.so", Mode: 0x81ed, Digest: "247aec091ff8cea3f34abaa5a56a1d8a255cfe18198b8655188d0aceb2648c50", Size: 12936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 85, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-ES-S.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-ES.so", Mode: 0x81ed, Digest: "542fa1b1b7c19cfffabe77cae3e9fa6be727a49a837aeed2e0990a9f684fec95", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 86, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-ES.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-FI-SE-A.so", Mode: 0x81ed, Digest: "5220d14db7feb86c460ac16286244e000afc64ac166adf999389f9cc8c35a67a", Size: 12936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 87, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-FI-SE-A.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-FI-SE.so", Mode: 0x81ed, Digest: "25ca67407e0f7abf98b603dd87e568fbb9c1b6c6cde730f32d2999e4651fdaa5", Size: 12936, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 88, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-FI-SE.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-FR.so", Mode: 0x81ed, Digest: "dd7baf45053b567de38cc985046e876111f09b29282f93454d2885e4e71d1a8b", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 89, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-FR.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-IS-FRISS.so", Mode: 0x81ed, Digest: "4b719eb6dbd58760b59cb30feb924f8800c3805b297f01c09570387f0aad74b1", Size: 12976, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 90, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-IS-FRISS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-IT.so", Mode: 0x81ed, Digest: "958b23145c8e97de5cb5b43165091633a6508f77ddf8d849ecb1a93b0f299f25", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 91, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-IT.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-PT.so", Mode: 0x81ed, Digest: "1a80a6659cf8df1315fb600288f4c8ba52cc1dc1ef75b02b08466397d4256f8b", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 92, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-PT.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-UK.so", Mode: 0x81ed, Digest: "55d379a230ddbae7dd5be337958b65f23fe9c17b152e37b5ba856e2b81e0c93f", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 93, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-UK.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EBCDIC-US.so", Mode: 0x81ed, Digest: "74a8e0b607ec48835ff5b6691806925bf50e235d2cf36c5cbc7c9bb0d451af92", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 94, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EBCDIC-US.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ECMA-CYRILLIC.so", Mode: 0x81ed, Digest: "87280bf714578f7ee7ae931d4457d327ce438fd1e63d84dba88fb264bc818226", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 95, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ECMA-CYRILLIC.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EUC-CN.so", Mode: 0x81ed, Digest: "cf0e798fa10f1866f48810c4b54ef9d0ab0285663f9deb48f492f0d0b2200320", Size: 17424, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 96, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EUC-CN.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libGB.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EUC-JISX0213.so", Mode: 0x81ed, Digest: "3367511f74cb96ffe94ca4432a668a628f73279090dc3e4466abc8f1d108a089", Size: 17256, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 97, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EUC-JISX0213.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libJISX0213.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EUC-JP-MS.so", Mode: 0x81ed, Digest: "24c8a2e1add46e6e07d05bf3ff94531435b7b995fea5ef6776cc6c3c5e311dbd", Size: 87032, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 98, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EUC-JP-MS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libJIS.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EUC-JP.so", Mode: 0x81ed, Digest: "d6b2d70ea33a80db73f0e9cc2fbff1575b816244a78727bebde8c9b66bb95b61", Size: 17416, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 99, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EUC-JP.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libJIS.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EUC-KR.so", Mode: 0x81ed, Digest: "b9e006366263fcc3096c00e3d94a4eb3c2f4ce3faf33da418ef9ee85ee9ccda2", Size: 13088, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 100, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EUC-KR.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libKSC.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/EUC-TW.so", Mode: 0x81ed, Digest: "25f5bf41bfe18d1633475c03861b84c07495ded4e270733ca87d574a92e3f8e4", Size: 26424, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 101, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "EUC-TW.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libCNS.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GB18030.so", Mode: 0x81ed, Digest: "d0c7d23d78b09291c01ec003fe0764a36e60b1b5075e697c18639e68d120eb46", Size: 185064, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 102, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GB18030.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GBBIG5.so", Mode: 0x81ed, Digest: "1fe9eaffe72dfddc7900ab5877a7eed10be6f627ee4af997528042fa4df41f1f", Size: 57968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 103, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GBBIG5.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GBGBK.so", Mode: 0x81ed, Digest: "033dc5034a3ff23c3413624e0413e8015408cb3a21029a72947e6ac09624bdaa", Size: 12816, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 104, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GBGBK.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GBK.so", Mode: 0x81ed, Digest: "d79f116d58aea8c5df541623adcc8e6811f5ae283eb1392946319e1307d93266", Size: 115784, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 105, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GBK.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GEORGIAN-ACADEMY.so", Mode: 0x81ed, Digest: "c2f3cfd87f5fa5fbde3fe20b7f6186dcb1c74c3eabd59e0b8275f91be988d689", Size: 12976, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 106, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GEORGIAN-ACADEMY.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GEORGIAN-PS.so", Mode: 0x81ed, Digest: "484a36b7a05637efa06f6775c9c8ddfed3a15a6bbabd8079c49a25b9cd0e1210", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 107, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GEORGIAN-PS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GOST_19768-74.so", Mode: 0x81ed, Digest: "b5a9cace944419f5820950cf26ca6f509e5e7da58af110474f9825ea91a96396", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 108, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GOST_19768-74.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GREEK-CCITT.so", Mode: 0x81ed, Digest: "14a7fb15f4419f3bdee2c681f075124003f827590bb7b2d5ae26cd60ac4d4f36", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 109, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GREEK-CCITT.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GREEK7-OLD.so", Mode: 0x81ed, Digest: "70b8ca5220d415feb253520c226e43cda0cb319d1b1057d1192ddb836d41e21c", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 110, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GREEK7-OLD.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/GREEK7.so", Mode: 0x81ed, Digest: "a8943672394fb71f7de6fee62993dfad1c96e74b29385e19bf6c3e7bc73bc2c6", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 111, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "GREEK7.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/HP-GREEK8.so", Mode: 0x81ed, Digest: "e771ff71b9a54067824f09c3603d6108ed78ee750c14eb74641aed4b654dd976", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 112, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "HP-GREEK8.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/HP-ROMAN8.so", Mode: 0x81ed, Digest: "32c8e4474b042405a537fa2b30f07b6b2abad7cb2398735baceed24416ce303c", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 113, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "HP-ROMAN8.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/HP-ROMAN9.so", Mode: 0x81ed, Digest: "e900ff11c922b3123f3664aabf631c214f7da428d46c9efb069c11cec8e9ff27", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 114, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "HP-ROMAN9.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/HP-THAI8.so", Mode: 0x81ed, Digest: "786301b5e375b262dab6d5e8dccf296defeeb53ae86f53998feb9e3b77dd5357", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 115, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "HP-THAI8.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/HP-TURKISH8.so", Mode: 0x81ed, Digest: "445058b3fb9ab2f8b7c25dac1267d3d4826870e8909665d28f27bedadd5fbb35", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 116, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "HP-TURKISH8.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM037.so", Mode: 0x81ed, Digest: "425cfb98c889c268373afc5bee6e01631852731fe15d9778ed7628842b05d992", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 117, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM037.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM038.so", Mode: 0x81ed, Digest: "3aca8582c85265063718a45b4ac6dfddda1ccfae5b4c30dee16311865e39151c", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 118, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM038.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1004.so", Mode: 0x81ed, Digest: "43992ca4d2cfb4bd3b9cb4fca07571b79e1fb4eefaff97db880264df39347a4a", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 119, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1004.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1008.so", Mode: 0x81ed, Digest: "3b49768acd1985cfe2e76be8fa22d6cdd56c08fbccce906d4d0ee59c3f92ba04", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 120, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1008.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1008_420.so", Mode: 0x81ed, Digest: "d6bbbbe54f83d86c306b8c2092342aea5fc56b82fcd88b52e2e8d0e3dfec3070", Size: 8832, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 121, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1008_420.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1025.so", Mode: 0x81ed, Digest: "f3c0e550cba622ef91b8a53102c5e4270c601673a0e54868bebce4cbf4a0b0e1", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 122, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1025.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1026.so", Mode: 0x81ed, Digest: "cc0184d5d65a555fee6b2c41c483c67330b110f6ed0bcc1ac650913f63db3a13", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 123, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1026.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1046.so", Mode: 0x81ed, Digest: "10a711755299fd7dff764acada6e364c32f12e4f73db01ad74cb247df1c521ec", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 124, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1046.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1047.so", Mode: 0x81ed, Digest: "52e4c9937da94d4aa599db2233df63879a1de7e7e212c696d109dc5454e14324", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 125, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1047.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1097.so", Mode: 0x81ed, Digest: "82b3616fe06b95b5a1d3f8dc1b2dca7d0b1beb0a8d3b73f68ac591e6abd3d457", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 126, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1097.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1112.so", Mode: 0x81ed, Digest: "6018d7ebe00e71a0784d6862d818cbaa4b48e274600ba30bd6493dc84479ebd6", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 127, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1112.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1122.so", Mode: 0x81ed, Digest: "c0faa948eb9584ac6b7ea44124e3980e37bb8256d2363f34186a36fee1187903", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 128, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1122.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1123.so", Mode: 0x81ed, Digest: "7b951fb124b3dad91ef6796715735ec492789f473cc386fdad22c5ec72c9eb27", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 129, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1123.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1124.so", Mode: 0x81ed, Digest: "efdd56158d1ad11d152f92fe3642d1239db58592ed8e89f9ca7aa443de741333", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 130, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1124.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1129.so", Mode: 0x81ed, Digest: "c40628c4672899313ef256ab1b9b65a037d2a3065ef57a049bee38f39a54c5a3", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 131, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1129.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1130.so", Mode: 0x81ed, Digest: "c04f69b5a23d4ea56a17465a1bef21b3bf7e85cd6d414652f802914f00ca08f5", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 132, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1130.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1132.so", Mode: 0x81ed, Digest: "5b8483c7b724ee0e976d40f7ac5ad288c5db60a295845741d34bca82c54332ae", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 133, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1132.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1133.so", Mode: 0x81ed, Digest: "204539c9917c49dcd4eafb3a2a9e50d8a25eb49f737c934bf5b2fa24753136be", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 134, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1133.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1137.so", Mode: 0x81ed, Digest: "cc23a512c915cbc9310b60ae63535eb327eb7c3835a124e1a7c21fc86ec76109", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 135, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1137.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1140.so", Mode: 0x81ed, Digest: "186fe8305040be8011b767dabd9d31b418f2099b96b6ec05329863d3d7b23ee5", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 136, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1140.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1141.so", Mode: 0x81ed, Digest: "53bb28773a26f735c76ec165e44be23dec8cb3adffe01b5ea0d27f1a2ffe3f4a", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 137, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1141.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1142.so", Mode: 0x81ed, Digest: "a9b84e13f5fc5e62e7c13a714efa90f72f8861eb13fe6f43d1ee8c65b96c1084", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 138, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1142.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1143.so", Mode: 0x81ed, Digest: "1063d45e72751c4fd3b9fa664df207c33c2a1a0663b6670063a136c02cb0ad71", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 139, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1143.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1144.so", Mode: 0x81ed, Digest: "f6af55bcdb776bee236ce28cedfa285b52b0b91a6e7e1659dd3e47ef8e0922bb", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 140, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1144.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1145.so", Mode: 0x81ed, Digest: "a5ba79f319f8838f4f7df383fffaaa68bc92bd85c9ef9b539ccf32dd2314b8f6", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 141, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1145.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1146.so", Mode: 0x81ed, Digest: "d3e38b5b995fcd653a2a5f898ed6e599c062a05d9dee248838a622533a23a982", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 142, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1146.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1147.so", Mode: 0x81ed, Digest: "8ef03c4cdb54b359415856dc0c0316a1c642090bd4601a0cd703cac368e59e88", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 143, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1147.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1148.so", Mode: 0x81ed, Digest: "ea4aff633ee245e1a2db9c31dcbc9d16afeaf79b75164963ae9316f4d555f764", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 144, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1148.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1149.so", Mode: 0x81ed, Digest: "348b8c947876ead5581452264ce8604eed0897966d65869b904dcba44f9fca54", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 145, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1149.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1153.so", Mode: 0x81ed, Digest: "0bf8e00624c78b6ff3ab224467769b91949f2460408c5aa071020d30d84858e8", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 146, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1153.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1154.so", Mode: 0x81ed, Digest: "4bdbda5dbbc19e94801c2d6222a7571a16db4e423df7c10f1482bf1f3945bf5a", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 147, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1154.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1155.so", Mode: 0x81ed, Digest: "5b9fdbccf79d1c2de443b77fc288d3422aa8271508ce216ecc0ff4f98bac11b8", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 148, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1155.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1156.so", Mode: 0x81ed, Digest: "db9bcc97b8cff1f0135e5f3ef24a176b6567418ec6b566ef4f0d23e9ba1d3e6d", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 149, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1156.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1157.so", Mode: 0x81ed, Digest: "bb166d507b85022dcb6443cf64b99ad925af7abfbbc6f51513917205e58dfcc6", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 150, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1157.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1158.so", Mode: 0x81ed, Digest: "e431c36d8d9c9a4d446b9bd55a2a184604b0f5704c30d7ad4852bf11f7c40e80", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 151, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1158.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1160.so", Mode: 0x81ed, Digest: "be64038146a91f2e00f47978a71db7cd7b100b779cf1a9a90e6ea247478b4f21", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 152, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1160.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1161.so", Mode: 0x81ed, Digest: "7984ab267971c7a4f915add42406c7ff9c5d688ed696a9c66067448000a8379f", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 153, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1161.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1162.so", Mode: 0x81ed, Digest: "2a83ff0bfb676ed8c782ce444df6b15226043e9702054c2ea2a24d9de5570b97", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 154, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1162.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1163.so", Mode: 0x81ed, Digest: "9b4e36dbcb7ac1a3a4ff15caabcf05a8dd33833f61b04964a1f30bf5e31c7f53", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 155, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1163.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1164.so", Mode: 0x81ed, Digest: "dcabaa9bf3da292d2cb6ad8757e404bbfce1f1f13288a721da441ed3bb6d2a7c", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 156, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1164.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1166.so", Mode: 0x81ed, Digest: "66b516cf29c0fbbae9191e06bd6bb25e8a9a58673e63376cf310b97559d9aa70", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 157, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1166.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1167.so", Mode: 0x81ed, Digest: "8525cdad406c2e0c549c5d9f133208406e31419bf5f6f9157e6497c4e3623a70", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 158, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1167.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM12712.so", Mode: 0x81ed, Digest: "643576aef3e0b66fa45e8a489abfb0150760a4e49c15615c7ac2345ffedbbb7e", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 159, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM12712.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1364.so", Mode: 0x81ed, Digest: "6dde92b7e4a7fdbe575c78a03fdb83794df8e9320abb8fdd7f9eeecb045be5cb", Size: 152408, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 160, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1364.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1371.so", Mode: 0x81ed, Digest: "8427ccba754f7cca355ec077dde8ce72cdbfdcb2e3c1e1d7223f29d8d5bb963b", Size: 127832, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 161, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1371.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1388.so", Mode: 0x81ed, Digest: "884199a6b3fa141bd8b94265703035f5e3f6502008262c16f485d3278d012b1a", Size: 172888, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 162, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1388.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1390.so", Mode: 0x81ed, Digest: "534ca78d2edfd037db714d8a239bbcb39da8cf16b75b62f596c94d1c42388b80", Size: 230336, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 163, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1390.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM1399.so", Mode: 0x81ed, Digest: "a82165f90de1e0a17256dd69ebe4ed06894798acd0d31b82ad776e12bbe5eb44", Size: 230336, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 164, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM1399.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM16804.so", Mode: 0x81ed, Digest: "43c8d40e2b0bab213802f180a0af912ee63fd2edf6e5536c6808d90b09cc2f6d", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 165, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM16804.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM256.so", Mode: 0x81ed, Digest: "9e20014decc14566834d77a1405e5a1ed9ad271b458c7af2c4df01894eb7ab7c", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 166, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM256.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM273.so", Mode: 0x81ed, Digest: "a1b169f9ddd7d47553a0f6cee28e6fdf18ac4f5dc5a1b9895ed3fad739e91c78", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 167, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM273.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM274.so", Mode: 0x81ed, Digest: "53a314fc378368913c87de2e8c47c567146748bf9093109379c07b8eaf7c3709", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 168, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM274.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM275.so", Mode: 0x81ed, Digest: "a4a9b2d0707aefa4554b7546efb0d961af6730d44cb75df70470a3fd4ff25ee4", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 169, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM275.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM277.so", Mode: 0x81ed, Digest: "5db16f5a7e8f62adacffd1380257b51c3a6c3773ac9a4b0a0177e6465f18c2a0", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 170, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM277.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM278.so", Mode: 0x81ed, Digest: "a4ec72a8882cae30d4b76052aaef0cfc6d5a5e2c23651d940d02dbc3a079607c", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 171, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM278.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM280.so", Mode: 0x81ed, Digest: "db0d8c15f27daaf8b752691427e21e66dbc016897ff9d8dde830547c4b02ac9f", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 172, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM280.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM281.so", Mode: 0x81ed, Digest: "453e38c625370c63085d0ead3fb6090416c13c43eb419269c8a1193ff420165b", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 173, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM281.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM284.so", Mode: 0x81ed, Digest: "5e1711bf7ca5c630da1fc48e516a4327b663b29bd02d6d7b9c545ac0c25df0ad", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 174, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM284.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM285.so", Mode: 0x81ed, Digest: "7d07f890be8fd1c0e81ed93cbd6e1c786e3628b4474974c3fce54408604f3eee", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 175, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM285.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM290.so", Mode: 0x81ed, Digest: "71222daa6b904a3de3085af13a9914f4bc13db150fa5be5928c06b9c3d7d0448", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 176, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM290.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM297.so", Mode: 0x81ed, Digest: "e1f83ff9d83028a035430bafffc41d22f1113b07d57361e69692f12d226d85c5", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 177, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM297.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM420.so", Mode: 0x81ed, Digest: "652ed558ecccdb463bc3b575bd79e1b06cc6bccab04b5d40c44d047574e32c40", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 178, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM420.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM423.so", Mode: 0x81ed, Digest: "467915473b431902f24145cbfe0612ef924f3c5f70cc0bd30dae432406bacb51", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 179, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM423.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM424.so", Mode: 0x81ed, Digest: "347f38466c512d069d48aecca495a2ccdb4275dd1e2bd66c7de0993ea6ad93e3", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 180, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM424.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM437.so", Mode: 0x81ed, Digest: "66459200279e8f1ac2ce9d607eff83a04086dca85f15adc4a2539321ee8db2f7", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 181, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM437.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM4517.so", Mode: 0x81ed, Digest: "e5e6b724a0e5926c312d325b8ef0cc69a09b50c87b03359b1668aecd4a24aa8c", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 182, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM4517.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM4899.so", Mode: 0x81ed, Digest: "ebdffa371b29d24276cf186d5c46b527c6c2044dce5cca563cfc3b445bb4ff44", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 183, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM4899.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM4909.so", Mode: 0x81ed, Digest: "c62376159e5cda2f24b41938e7bfb41c0172e87b6512aff164b086b4637b0e40", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 184, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM4909.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM4971.so", Mode: 0x81ed, Digest: "8948e0297c9f304499acb6c9f1771100bc42d148c43da51b002dfce042fc2916", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 185, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM4971.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM500.so", Mode: 0x81ed, Digest: "f6ef759e605a3caf647594c20a85b1320126ec8a82bf5e19ca3f7a3efa4e6809", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 186, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM500.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM5347.so", Mode: 0x81ed, Digest: "66c3957d3205ee50937ef999723b9111c43a80fd007125ecf6f5998b5dfc27cb", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 187, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM5347.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM803.so", Mode: 0x81ed, Digest: "c30646f442d3a91a3f1653c0de9fee1a5082316eb2d30ae05caf895a4f8ce1b2", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 188, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM803.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM850.so", Mode: 0x81ed, Digest: "582e37871f0e561c6f2aa0c19cd6c71ab5fcb305cbcf75f598d09c8ccfeada6c", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 189, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM850.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM851.so", Mode: 0x81ed, Digest: "817dfc538b05a9d68a192816f920fb25e3e958afa3db624ee0f249a9df931074", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 190, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM851.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM852.so", Mode: 0x81ed, Digest: "fbb4757ac5ef44330b973491711fa0402af065a96b559026a9b1a558355bcbbd", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 191, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM852.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM855.so", Mode: 0x81ed, Digest: "e64bd0c29d66dc6d970408f259bfcc30a46b526033a2c405db039ae31f9d2144", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 192, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM855.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM856.so", Mode: 0x81ed, Digest: "ca536d16ed76de3fc7ec756e6b4a7741864b2e5ded7cc78fe431dec9f9aa68cb", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 193, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM856.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM857.so", Mode: 0x81ed, Digest: "414258a6c41cace42dc502f05555e0c837994619a557da1f92358245012c94bd", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 194, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM857.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM860.so", Mode: 0x81ed, Digest: "c5f96217983e7d8a686145fb24f48e708e35b586d967c0198c8a4ac3aa8356bb", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 195, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM860.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM861.so", Mode: 0x81ed, Digest: "9a3e5292ecdac5020bfdb7c1f5c94912af865f1020334d8d8bf0895ba3276978", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 196, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM861.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM862.so", Mode: 0x81ed, Digest: "56e7dc94a966c36ee7dcc0acea0fdae2c29e3628fed9434909c10c1bd6325c71", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 197, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM862.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM863.so", Mode: 0x81ed, Digest: "eda3501dbaca3c5664cd4c638455350cdbf53544f942db318e43801bf276d32e", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 198, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM863.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM864.so", Mode: 0x81ed, Digest: "61e561884ca05e2ce3b116adc3b07573d99fbc3805ee61db51923356ef4e8f51", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 199, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM864.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM865.so", Mode: 0x81ed, Digest: "f9c10f386c08409fd56820c7f923126f89d48423602502651baf786fd30cdfdd", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 200, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM865.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM866.so", Mode: 0x81ed, Digest: "58dbf7ed066c6a6443b929647db41a9364ac3325d5156d0a3d3d2d5eec59a03a", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 201, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM866.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM866NAV.so", Mode: 0x81ed, Digest: "10d7efe3ad25e4d2e9354f07e0ba4aed7b0b5d33ce862cd0a47a9ea9065046b0", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 202, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM866NAV.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM868.so", Mode: 0x81ed, Digest: "fd2fddf0e49182fb20d9346465431afef951cc2100b4e88a36d60f97d1e8551b", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 203, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM868.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM869.so", Mode: 0x81ed, Digest: "f815721dbc62432a2859e7dcfff94eb48c74a81a95cf99ed2a387748126acc3e", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 204, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM869.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM870.so", Mode: 0x81ed, Digest: "c7370be8e0cdc6ae1d5411d543888e3e926fc5cf007d4212b0ab55f6b18b6fc9", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414861, Inode: 205, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM870.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM871.so", Mode: 0x81ed, Digest: "c49d504a3389553cfd32b62ddb4f5615c6d3d042e9ee53a2f49255f92b75a204", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 206, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM871.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM874.so", Mode: 0x81ed, Digest: "ee18f36056ccdc3ea0ea51170b7b13cc4b3d177ad03ff0f87fb8faeaf1815a0c", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 207, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM874.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM875.so", Mode: 0x81ed, Digest: "c3819c91502c3fe5a97bddd8b24cc1591c86af22b45592b0632887cd27255cdc", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 208, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM875.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM880.so", Mode: 0x81ed, Digest: "db40834acd51008dfb6401f02f4a605be5bb3c2ba276d7f2b194e5a789f91d05", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 209, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM880.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM891.so", Mode: 0x81ed, Digest: "efea7096e7f631c8802ef8524f6989a2a19fcd4eed21c03afa4d4dfe349f7a91", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 210, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM891.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM901.so", Mode: 0x81ed, Digest: "524c7644ba6ef81246c00c43eb293d35e65974ca475ec6223e100f2d6dbe2a83", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 211, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM901.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM902.so", Mode: 0x81ed, Digest: "a95583091f5d6b4faecba4d438f69b2a6f56873bf952510527d42c0ae5e80f3a", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 212, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM902.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM903.so", Mode: 0x81ed, Digest: "ab1005eef92d77c337e4fade4df20e46bfd652e723e509f23fcf59cdbf401c3f", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 213, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM903.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM9030.so", Mode: 0x81ed, Digest: "602800323179c22541d81e0fcd4a9c087c9163f8d54bc4cb8bbd5946f2a19ea8", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 214, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM9030.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM904.so", Mode: 0x81ed, Digest: "e9553a1bcc614fed1c588ba17e7d7268dd882df4e5b43f8af90f60f747bb0c73", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 215, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM904.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM905.so", Mode: 0x81ed, Digest: "6675d58a2186807342842f4dc8c34003f8100f3d909276e1ca4d8f59d2f9c335", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 216, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM905.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM9066.so", Mode: 0x81ed, Digest: "e53025857073d03318b256aaf3fb6701f2ccafa86b23e7a64af5499c5a2ae1db", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 217, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM9066.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM918.so", Mode: 0x81ed, Digest: "dc4530d17ccb16db0fc95f0a72d7b62586dc58f9d3613073fa2746f1b7fee8d9", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 218, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM918.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM921.so", Mode: 0x81ed, Digest: "01d7f9d67c172a8215c87b9a0ab33e50fa0fcc78e4cbf59099bfb1185ba90fe1", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 219, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM921.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM922.so", Mode: 0x81ed, Digest: "dd81e29d1fbb15ba3b2e27c3cd2cecca2836e89e6e9979a17ff47c455a859845", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 220, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM922.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM930.so", Mode: 0x81ed, Digest: "b9a0eb5c7a44351e3ec991f465a614840339660f6346c5874380399f15a705e4", Size: 107344, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 221, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM930.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM932.so", Mode: 0x81ed, Digest: "7cd334612653e34c25cc11a793d446f9744447d7465f9aadac71afdc4fe75158", Size: 70464, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 222, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM932.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM933.so", Mode: 0x81ed, Digest: "60a36caa21eec08a70af57799f53dcbdda8154ccd455b6a231bdf6dae8562317", Size: 127824, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 223, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM933.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM935.so", Mode: 0x81ed, Digest: "859e645a585f3b2300cf9c541451394ed87ed5a115afa0132915f19a37a42c30", Size: 95056, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 224, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM935.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM937.so", Mode: 0x81ed, Digest: "b606fb90bdc098198390dea0d4c4b61b96fc005c3a06e1f5188ac748438bcdb6", Size: 115536, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 225, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM937.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM939.so", Mode: 0x81ed, Digest: "e65c7203ddea0f9be74999df1c80087ea64b8f2aaa5b228600aed6fd443956c3", Size: 107344, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 226, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM939.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM943.so", Mode: 0x81ed, Digest: "651026e2945739d24343c68c8fb4825b1c972cd62139198574457847be7f7f99", Size: 70464, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 227, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM943.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IBM9448.so", Mode: 0x81ed, Digest: "562033b7733a9da270fc3dcb63742798efd7ae2eff37876e5f53703431453806", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 228, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IBM9448.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/IEC_P27-1.so", Mode: 0x81ed, Digest: "ed3c73c1b322a56fd16ba0f59f67621801179d08b25d300550d15c7be10f651c", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 229, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "IEC_P27-1.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/INIS-8.so", Mode: 0x81ed, Digest: "2bb9fcf9e07d668cd730eba6f39a8f30910e38f431d246094f618903b36880de", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 230, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "INIS-8.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/INIS-CYRILLIC.so", Mode: 0x81ed, Digest: "5a63beb032390f4bdeff271e0685635106e73e082d7ffc6a3e51136050bdd7f7", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 231, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "INIS-CYRILLIC.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/INIS.so", Mode: 0x81ed, Digest: "2f70b714ca058b863236c952d45346c97d65499cf921eb6beade6533aeda1860", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 232, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "INIS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISIRI-3342.so", Mode: 0x81ed, Digest: "55549d34b791ab9c1b5ff7e5bd1eaeb6449c50d4cae015287fc8617e9a7486dc", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 233, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISIRI-3342.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO-2022-CN-EXT.so", Mode: 0x81ed, Digest: "233e18a5023aa322aefa3ba30eca3dab207d36c52d7c26e1e9c0fca438e306ad", Size: 47488, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 234, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO-2022-CN-EXT.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libCNS.so()(64bit)", Flags: 16384}, {Name: "libGB.so()(64bit)", Flags: 16384}, {Name: "libISOIR165.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO-2022-CN.so", Mode: 0x81ed, Digest: "a34beadeabbb7ff9c2608bb59175162b1c27f24a6f2d721264e750d8f35219e1", Size: 34664, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 235, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO-2022-CN.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libCNS.so()(64bit)", Flags: 16384}, {Name: "libGB.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO-2022-JP-3.so", Mode: 0x81ed, Digest: "95d8505170dc3ed0194108658ccd565816c4a24510d7fbdaf76df4db2225c266", Size: 25696, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 236, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO-2022-JP-3.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libJIS.so()(64bit)", Flags: 16384}, {Name: "libJISX0213.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO-2022-JP.so", Mode: 0x81ed, Digest: "8a66e38b3e2ca397701511f05a3b43ff36b51ad4dec14189c303579c75462d43", Size: 43080, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 237, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO-2022-JP.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libGB.so()(64bit)", Flags: 16384}, {Name: "libJIS.so()(64bit)", Flags: 16384}, {Name: "libKSC.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO-2022-KR.so", Mode: 0x81ed, Digest: "fc56396c6fe5ece00c86491fde33ac978e8a5c86f2d167f4a8d3d69e5c1548ab", Size: 17168, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 238, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO-2022-KR.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libKSC.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO-IR-197.so", Mode: 0x81ed, Digest: "dcbd420049c2fcb02d5496861a6f195b26aa94263e331f2e82957897bb361e1c", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 239, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO-IR-197.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO-IR-209.so", Mode: 0x81ed, Digest: "776a0f104862ab9cdbceab290e1bfe20dda86548ebc5fe338fed3394aa70a82c", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 240, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO-IR-209.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO646.so", Mode: 0x81ed, Digest: "e158d39fb07417594261d46f1b04bb7873a8d0f94aa615b8d3a278d3d4eb0381", Size: 29424, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 241, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO646.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-1.so", Mode: 0x81ed, Digest: "968ce054a2b284d2b80c18d87d560869c215f2e58984d9edd0dc426c1b6693a0", Size: 12864, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 242, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-1.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-10.so", Mode: 0x81ed, Digest: "4f99ab6758ca01cd6c1a982439e9c3463a7db05a6f4c6a6c29924a0cd9474efb", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 243, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-10.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-11.so", Mode: 0x81ed, Digest: "25d87660e0b6223423e5a8e480f677f9fd36739afec428773c6b0919a3753517", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 244, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-11.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-13.so", Mode: 0x81ed, Digest: "c0d5bb8abc2369663190e5cf33b168188c399883ab967370cd9b1dce41b8543e", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 245, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-13.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-14.so", Mode: 0x81ed, Digest: "fd5abc392ac4a2e008a82718a2678f2f63aec733dcd5760cc0c45e2e1edd3472", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 246, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-14.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-15.so", Mode: 0x81ed, Digest: "079b2dfe86d2e4ad7b8b447dc1413f4ea09363a1bb7cc5d6e504f97a54f1a29b", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 247, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-15.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-16.so", Mode: 0x81ed, Digest: "3258073881081b32c4ff3d423f3b9b88c41f963c7fc7911fb2d8819267396741", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 248, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-16.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-2.so", Mode: 0x81ed, Digest: "c9ec4ca3666de17a0935abc96984b5519c714082dc8b2d3433fc74071cfbc55d", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 249, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-2.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-3.so", Mode: 0x81ed, Digest: "2761782840c784b52cd88247ebed268723f60b75d39e3688fa3da7319677d708", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 250, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-3.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-4.so", Mode: 0x81ed, Digest: "4b52d71d5735ddd7b36699591656aeb522749d0fac03bd8fad66b6cb57875911", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 251, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-4.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-5.so", Mode: 0x81ed, Digest: "caa9d83d16a6ae7bcc09492457b40401b472d1e43f62aa0d8babc936bee920f6", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 252, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-5.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-6.so", Mode: 0x81ed, Digest: "0a2b787fe59d9c46db27a8c79b85c95d7fd59d8c302a39b607c559d779abf22a", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 253, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-6.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-7.so", Mode: 0x81ed, Digest: "80a387435ca8b52f775a4f50d38643cb45fc7a8623351d96413c01ef2903b293", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 254, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-7.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-8.so", Mode: 0x81ed, Digest: "3cc7b590c220df601961dddf543811a0b0fd5c4c9b42a3b2c878985c1e03fbf0", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 255, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-8.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-9.so", Mode: 0x81ed, Digest: "fa01dcd538bf4a73b8f4ee3c18dbaba6e3d48b9d5ac397756bea8279191fd0cd", Size: 12928, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 256, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-9.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO8859-9E.so", Mode: 0x81ed, Digest: "538379737c13e3b5bfaedd36bf361a1cf9cac0e4bd3b8ec8cb12b8ee3e2ce6a8", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 257, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO8859-9E.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO_10367-BOX.so", Mode: 0x81ed, Digest: "2fa45a5407a0baed9f340e81d49a3af887cba6187b32f7889df1dcb13f366721", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 258, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO_10367-BOX.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO_11548-1.so", Mode: 0x81ed, Digest: "f9fc05588f8cf6cb87108d09ac2dedea4001c85725937867d54fdce0b9c78322", Size: 12872, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 259, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO_11548-1.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO_2033.so", Mode: 0x81ed, Digest: "b615c2a0b3754ab22e26647f23d3bf8cf715e09ab6b448b0db5ee93a45beed72", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 260, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO_2033.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO_5427-EXT.so", Mode: 0x81ed, Digest: "bb178689f4fbc6386035e4a07044aa935a427c366fbd3d455bff1d0478b8b342", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 261, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO_5427-EXT.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO_5427.so", Mode: 0x81ed, Digest: "b5848cf336f2c5f2f4aff2163d4702ab5aa0070f20ebb5bb8da64d55c6a2314f", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 262, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO_5427.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO_5428.so", Mode: 0x81ed, Digest: "12262d118494ad6e9c73c37b6127dcfeeb8a456f76c4cd2f1d4abf319d2a05a8", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 263, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO_5428.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO_6937-2.so", Mode: 0x81ed, Digest: "827221fe4800c76fe93de4b740a6c32166543f34dff466c720cf5dabd963f62c", Size: 21224, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 264, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO_6937-2.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/ISO_6937.so", Mode: 0x81ed, Digest: "f2db0c236632d3935758cd971a84981e103f1ca78c78466f4c3a87d7e3b5082e", Size: 25320, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 265, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "ISO_6937.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/JOHAB.so", Mode: 0x81ed, Digest: "b32a2ab97f86f448d18d6382b1f2dd0f7e22b39fdb9be66dc278efab10fd8b77", Size: 17448, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 266, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "JOHAB.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libKSC.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/KOI-8.so", Mode: 0x81ed, Digest: "f7c2285643cf5a88f9c254d69737359970183e6e8654f659b60a086fa429c338", Size: 12920, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 267, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "KOI-8.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/KOI8-R.so", Mode: 0x81ed, Digest: "ab9d2abe4a0a5d5cd24ff48c055cbae39bdd5ed6e670dc29e20dbd5c77a675c0", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 268, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "KOI8-R.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/KOI8-RU.so", Mode: 0x81ed, Digest: "404b0886fbfd342b045f7910e47190e1f20485472a3e453bb6a2136160da7964", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 269, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "KOI8-RU.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/KOI8-T.so", Mode: 0x81ed, Digest: "0dac37cd0ce494f3536174ac50395e9cb0cd7da98782a4a4e3ef44d5ec715dd5", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 270, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "KOI8-T.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/KOI8-U.so", Mode: 0x81ed, Digest: "a8c038e01d28901f229d133a7d1dcccda933eb8d9164b5261afa2d0849cbc740", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 271, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "KOI8-U.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/LATIN-GREEK-1.so", Mode: 0x81ed, Digest: "f087836226ad927459559461054dd761a977e94e04812069fa3ae7d32c3e17a2", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 272, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "LATIN-GREEK-1.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/LATIN-GREEK.so", Mode: 0x81ed, Digest: "14fc03ff22fee470dfd217126fd02e87e6d8188c73559c04197d6919988d3d03", Size: 12968, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414869, Inode: 273, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "LATIN-GREEK.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/MAC-CENTRALEUROPE.so", Mode: 0x81ed, Digest: "e9a4bf52d8525390ce68b5864bb6ba1d9a49297eaa3b1b71527ece549cbb07d7", Size: 12976, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 274, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "MAC-CENTRALEUROPE.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/MAC-IS.so", Mode: 0x81ed, Digest: "93bb4ddfb8a74c1e6f315d4dec5ceb0028050e084b0595ab9c0cd04bb17b4c90", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414862, Inode: 275, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "MAC-IS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/MAC-SAMI.so", Mode: 0x81ed, Digest: "17caa423bb02429d1118e8419e4287cf38b07c968be890ee1af900cccf4c640a", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 276, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "MAC-SAMI.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/MAC-UK.so", Mode: 0x81ed, Digest: "19dc5563f0528392697f6fa20743ef2eb7469e615bcf63cce8dd8fa08aab62c0", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 277, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "MAC-UK.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/MACINTOSH.so", Mode: 0x81ed, Digest: "7fe9811d921f9d33931f0fbbf2c7fe4ee7884f08167ab514efa7a1d824bf34f0", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 278, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "MACINTOSH.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/MIK.so", Mode: 0x81ed, Digest: "1755c53332dc5eecfc6fe957f5edb5079ffd3b3a7e61b5d9d96f9841110987e1", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 279, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "MIK.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/NATS-DANO.so", Mode: 0x81ed, Digest: "89c3ee6c5a5282b968049b5cb12cc98c9c89899746f9797fe4e341d9e3546d2b", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 280, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "NATS-DANO.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/NATS-SEFI.so", Mode: 0x81ed, Digest: "022d837a738a37b74e3efab3c2563bb28442b9098b91bc709ddc6b9e69a52f35", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414875, Inode: 281, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "NATS-SEFI.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/PT154.so", Mode: 0x81ed, Digest: "00b432e9fb6736c990aaccccaecb211aab3acb0d671d88bcc7008b220895d6cb", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 282, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "PT154.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/RK1048.so", Mode: 0x81ed, Digest: "01a5f2e5c6f61cfcf1155278a22eacc10113e6a42d3507d15f32790afc558539", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414872, Inode: 283, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "RK1048.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/SAMI-WS2.so", Mode: 0x81ed, Digest: "421d015c53f05140da80170e12be6231006a090549aa039470960b9216ca1acc", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 284, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "SAMI-WS2.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/SHIFT_JISX0213.so", Mode: 0x81ed, Digest: "5e1792695e6af92932768d2ff788a0ffc20512d8e622d0da29fdfa606dc44dbc", Size: 17264, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414863, Inode: 285, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "SHIFT_JISX0213.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libJISX0213.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/SJIS.so", Mode: 0x81ed, Digest: "07b9a79ceb2160ad9d36c816ee3dd95dc4056d779bc4b923d6cb3ca6c3460a8b", Size: 95072, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 286, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "SJIS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/T.61.so", Mode: 0x81ed, Digest: "90ccfefe08a9c813d65fb75b25da1735b75c4ec780ab31d84923a3cf61c7f825", Size: 21216, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414866, Inode: 287, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "T.61.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/TCVN5712-1.so", Mode: 0x81ed, Digest: "d0a2b63df0846a77277c27264d55cf5421d5e23d6d00d692514ea325e22e46b1", Size: 17240, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414864, Inode: 288, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "TCVN5712-1.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/TIS-620.so", Mode: 0x81ed, Digest: "e2f4ef81de5e7edaf8b75d3e0458d976f6ba17466bbc897541f11c941084ba42", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 289, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "TIS-620.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/TSCII.so", Mode: 0x81ed, Digest: "5a188ed2be198af3f8ce939bf546cc824319a882bafc310fc51717ce090dbef9", Size: 21256, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 290, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "TSCII.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/UHC.so", Mode: 0x81ed, Digest: "486dfa345d12e83ae83595ca20d1899fc91f57cdb455da4fdad2a420d3a16c1b", Size: 74616, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 291, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "UHC.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libKSC.so()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/UNICODE.so", Mode: 0x81ed, Digest: "a934e46295f94147b9a70ab07b3e9c97730cc92e99606d34c036dac807a8ec4b", Size: 12960, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 292, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "UNICODE.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/UTF-16.so", Mode: 0x81ed, Digest: "249e5e492a813de719a51fcf92f4776f35aac93407de143c725ae7c09db6b0db", Size: 13016, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414870, Inode: 293, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "UTF-16.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/UTF-32.so", Mode: 0x81ed, Digest: "690df77765c61dfc55f27b3f3d124923dd6e2d707e37fd751311c7800e0a648b", Size: 13016, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414868, Inode: 294, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "UTF-32.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/UTF-7.so", Mode: 0x81ed, Digest: "97d9709f586804ea4743cd82e159ffd3e9d9cdd8e87dd3eae092c15a3402c4c5", Size: 21200, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 295, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "UTF-7.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/VISCII.so", Mode: 0x81ed, Digest: "cc4284e8b380fc4db4c96c69432fe9eb52d3b12f7f7b421a9c50766e1a023de2", Size: 12952, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414867, Inode: 296, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "VISCII.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/gconv-modules", Mode: 0x81a4, Digest: "caa9c4b59d20b59f1430cb0d8a40100ce96251b2594129e8253ae10aad7019ae", Size: 56103, Username: "root", Groupname: "root", Flags: 17, MTime: 1529414154, Inode: 297, Device: 1, Class: "ASCII text", VerifyFlags: 0xffffffdc},
+		{Path: "/usr/lib64/gconv/gconv-modules.cache", Mode: 0x81a4, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414194, Inode: 298, Device: 1, Class: "empty", VerifyFlags: 0xffffffdc},
+		{Path: "/usr/lib64/gconv/libCNS.so", Mode: 0x81ed, Digest: "fda992879a3ed74e8a18d3b41922465903acac3e72ff8e27b166b67075056e3a", Size: 472600, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 299, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libCNS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/libGB.so", Mode: 0x81ed, Digest: "0c65e1ffa767b92f43a2d2043e0e2fd60d5d491c76733c374e7a5cf046d28646", Size: 70344, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414865, Inode: 300, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libGB.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/libISOIR165.so", Mode: 0x81ed, Digest: "5884d9ab2010f025826259ac2fb3ee826c17eb0dda58d9545880a792f7c00966", Size: 61824, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414873, Inode: 301, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libISOIR165.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/libJIS.so", Mode: 0x81ed, Digest: "fb592d1dd7624dfdf76da191fdeb382c4da6c61ec53f506eb40b174dfe38ed61", Size: 103168, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 302, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libJIS.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/libJISX0213.so", Mode: 0x81ed, Digest: "56c3c442aa81192a78996ec64b378141fb0da42ff05fdcbbea3afb4ae9db15cc", Size: 123384, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414874, Inode: 303, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libJISX0213.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/gconv/libKSC.so", Mode: 0x81ed, Digest: "16207e9305df9960417e127ac99c9dcdb66eaf8b1d1948461d4658d7be916c86", Size: 49632, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414871, Inode: 304, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libKSC.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/libmemusage.so", Mode: 0x81ed, Digest: "2430154ed9e5af1995446e43f009787f40b3fc8a00c61c53779d9c6d47a2c2ed", Size: 23808, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414861, Inode: 305, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libmemusage.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "ld-linux-x86-64.so.2(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libdl.so.2()(64bit)", Flags: 16384}, {Name: "libdl.so.2(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/lib64/libpcprofile.so", Mode: 0x81ed, Digest: "017eeba9d6a4e77ebf61dc6d3df85f4ee5479c592b69762d492a51c786f70933", Size: 9272, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414861, Inode: 306, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, not stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libpcprofile.so()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/libexec/getconf", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 307, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/libexec/getconf/POSIX_V6_LP64_OFF64", Mode: 0x81ed, Digest: "bad7760cfdca61b76cae487e050e063c92725084c87917d467900fe5e9ed7a72", Size: 22808, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 308, Device: 1, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/libexec/getconf/POSIX_V7_LP64_OFF64", Mode: 0x81ed, Digest: "bad7760cfdca61b76cae487e050e063c92725084c87917d467900fe5e9ed7a72", Size: 22808, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 308, Device: 1, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}}},
+		{Path: "/usr/sbin/glibc_post_upgrade.x86_64", Mode: 0x81c0, Digest: "31449eff6ea6cb37ce03099141f27f71aa992089970aa94a7b359ab363a8c129", Size: 692856, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 310, Device: 1, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (GNU/Linux), statically linked, for GNU/Linux 2.6.18, stripped", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/sbin/iconvconfig", Mode: 0x81ed, Digest: "7b3ad7c4db6e9419b197c90b3b7596961e1e0b0613d2ce4169ca8e6098d19e3e", Size: 23056, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 311, Device: 1, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}}},
+		{Path: "/usr/sbin/iconvconfig.x86_64", Mode: 0x81ed, Digest: "7b3ad7c4db6e9419b197c90b3b7596961e1e0b0613d2ce4169ca8e6098d19e3e", Size: 23056, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 311, Device: 1, Color: 2, Class: "ELF 64-bit LSB executable, x86-64, version 1 (SYSV), dynamically linked (uses shared libs), for GNU/Linux 2.6.18, stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}}},
+		{Path: "/usr/share/doc/glibc-2.12", Mode: 0x41ed, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414879, Inode: 313, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/BUGS", Mode: 0x81a4, Digest: "9b2d4b25c8600508e1d148feeaed5da04a13daf988d5854012aebcc37fd84ef6", Size: 2954, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 314, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/CONFORMANCE", Mode: 0x81a4, Digest: "64753d94e291cb24b0dceec2e32bd6a0bba35b8d74a6d64d9ea345705088dc88", Size: 6598, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 315, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/COPYING", Mode: 0x81a4, Digest: "204d8eff92f95aac4df6c8122bc1505f468f3a901e5a4cc08940e0ede1938994", Size: 18009, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 316, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/COPYING.LIB", Mode: 0x81a4, Digest: "ca0061fc1381a3ab242310e4b3f56389f28e3d460eb2fd822ed7a21c6f030532", Size: 26536, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 317, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/FAQ", Mode: 0x81a4, Digest: "21705edf8c77e38140afe51b3e0b762afcf464b0c210fe51a85a789191e3c7ef", Size: 84483, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 318, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/INSTALL", Mode: 0x81a4, Digest: "7905f20dc8bf7a0e0d375ee3b70c67f2eba7c52a3436ae8f16b1732abc3dc13b", Size: 22140, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 319, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/LICENSES", Mode: 0x81a4, Digest: "291c84cdb0ece86692c555bb6b61d6229c95c1d83c14751360ace8de17ad5b79", Size: 10728, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 320, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/NEWS", Mode: 0x81a4, Digest: "5bd87874e54b96440f697edcd1cb37cab75b48393605241cb994819bfc324f23", Size: 72289, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 321, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/NOTES", Mode: 0x81a4, Digest: "179b5fe54f02281b21f133cc8757db265af2b403b8978641e7ad6bf8f1ca9e13", Size: 10702, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 322, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/PROJECTS", Mode: 0x81a4, Digest: "b3ea3fbdc20ac7e3477fccb2a3e7e81d4f7e429644379a91455c39b785202a52", Size: 4155, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 323, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/README", Mode: 0x81a4, Digest: "7ea3e20637ecd9ddbc5ca7b23d41003437cf3dd50e309b95aeb239018582fb2b", Size: 4838, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 324, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/README.hesiod", Mode: 0x81a4, Digest: "71b88fbf6a491615b8370677f6cf49d518aa5d85bfba3da36252bc0e43939729", Size: 5672, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 325, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/glibc-2.12/README.libm", Mode: 0x81a4, Digest: "b4f55a722fcfa05d23ebea15705084d1c307617458414dc758bcf7ffdb009703", Size: 31517, Username: "root", Groupname: "root", Flags: 2, MTime: 1272972443, Inode: 326, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/var/cache/ldconfig", Mode: 0x41c0, Digest: "", Size: 4096, Username: "root", Groupname: "root", Flags: 0, MTime: 1529414877, Inode: 327, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/var/cache/ldconfig/aux-cache", Mode: 0x8180, Digest: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0, Username: "root", Groupname: "root", Flags: 89, MTime: 1529414877, Inode: 328, Device: 1, Class: "empty", VerifyFlags: 0xffffffd8},
 	}
 
 	CentOS8NodejsInstalledFiles = []FileInfo{
-		{Path: "/usr/bin/node", Mode: 0x81ed, Digest: "b894371f1e9e4fc29eb10f06537f7e9d95302b75f43797b63ded087c56a2a1b4", Size: 31194672, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib/.build-id", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096},
-		{Path: "/usr/lib/.build-id/4c", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096},
-		{Path: "/usr/lib/.build-id/4c/1f16ab7e1bbbf8f56dfbf1a098b6e6a6a9bbe4", Mode: 0xa1ff, Digest: "", Size: 24, Username: "root", Groupname: "root", Flags: 4096},
-		{Path: "/usr/lib/node_modules", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib/rpm/fileattrs/nodejs_native.attr", Mode: 0x81a4, Digest: "991876f5bd2383feac22a58397641096d62045629f6e107cb9d211e626d7e226", Size: 119, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib/rpm/nodejs_native.req", Mode: 0x81ed, Digest: "2eb0511016eb87b70278b06c3af7ed39986ac3dc43cad8239060e69c764c3a07", Size: 70, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/doc/nodejs", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/doc/nodejs/AUTHORS", Mode: 0x81a4, Digest: "692b7cc46bbc9630c6e0d7b5692ea9dd970e5a578264813568fa30ec11e193ee", Size: 87615, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/nodejs/CHANGELOG.md", Mode: 0x81a4, Digest: "994b0861c5f28d4a57101d746024333b12e09f3d6b825d04ea3f34f36efd0952", Size: 52452, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/nodejs/COLLABORATOR_GUIDE.md", Mode: 0x81a4, Digest: "17d2103cb77e34fe60ba703dfba90769ff8d6b3e9be75cad598d0286ee7b84bc", Size: 36320, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/nodejs/GOVERNANCE.md", Mode: 0x81a4, Digest: "55e859154a49870e8a5b41ef216fedcc2a7d375b2f87167b25e30f408209226e", Size: 8676, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/doc/nodejs/README.md", Mode: 0x81a4, Digest: "ff363d76470542a790a00688d377a0164e6ace34f87042f9bcdd23e78b5b0c26", Size: 26749, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/licenses/nodejs", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/licenses/nodejs/LICENSE", Mode: 0x81a4, Digest: "2b0fe00a83916d0290c8531db25a827e18d01e7c4bf000e9a0f2e826604ba41e", Size: 68764, Username: "root", Groupname: "root", Flags: 128},
-		{Path: "/usr/share/man/man1/node.1.gz", Mode: 0x81a4, Digest: "492c658669822df426eeeeffcd33572f9801f06ddfec2a5ab6ee622943d613ea", Size: 4849, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/node", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/systemtap", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/systemtap/tapset", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/systemtap/tapset/node.stp", Mode: 0x81a4, Digest: "1df168860f170690b202271d7f604514e64831523db62e7bae3f9a85cbbd7bf4", Size: 3495, Username: "root", Groupname: "root", Flags: 0},
+		{Path: "/usr/bin/node", Mode: 0x81ed, Digest: "b894371f1e9e4fc29eb10f06537f7e9d95302b75f43797b63ded087c56a2a1b4", Size: 31194672, Username: "root", Groupname: "root", Flags: 0, MTime: 1594133065, Inode: 1, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (GNU/Linux), dynamically linked, interpreter /lib64/ld-linux-x86-64.so.2, for GNU/Linux 3.2.0, BuildID[sha1]=4c1f16ab7e1bbbf8f56dfbf1a098b6e6a6a9bbe4, stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "librt.so.1(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libgcc_s.so.1(GCC_3.4)(64bit)", Flags: 16384}, {Name: "libgcc_s.so.1(GCC_3.0)(64bit)", Flags: 16384}, {Name: "libm.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libpthread.so.0(GLIBC_2.3.3)(64bit)", Flags: 16384}, {Name: "libpthread.so.0(GLIBC_2.3.2)(64bit)", Flags: 16384}, {Name: "libpthread.so.0(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.7)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.15)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.9)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.4)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.6)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.28)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.4)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.14)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.2)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libdl.so.2(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libssl.so.1.1(OPENSSL_1_1_1)(64bit)", Flags: 16384}, {Name: "libssl.so.1.1(OPENSSL_1_1_0)(64bit)", Flags: 16384}, {Name: "libcrypto.so.1.1(OPENSSL_1_1_1)(64bit)", Flags: 16384}, {Name: "libcrypto.so.1.1(OPENSSL_1_1_0)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(GLIBCXX_3.4.14)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(CXXABI_1.3.8)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(GLIBCXX_3.4.18)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(CXXABI_1.3.5)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(GLIBCXX_3.4.11)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(GLIBCXX_3.4.15)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(GLIBCXX_3.4.9)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(CXXABI_1.3)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(GLIBCXX_3.4.20)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(CXXABI_1.3.2)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(GLIBCXX_3.4)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(GLIBCXX_3.4.21)(64bit)", Flags: 16384}, {Name: "libstdc++.so.6(CXXABI_1.3.9)(64bit)", Flags: 16384}, {Name: "libz.so.1()(64bit)", Flags: 16384}, {Name: "libcrypto.so.1.1()(64bit)", Flags: 16384}, {Name: "libssl.so.1.1()(64bit)", Flags: 16384}, {Name: "libdl.so.2()(64bit)", Flags: 16384}, {Name: "librt.so.1()(64bit)", Flags: 16384}, {Name: "libstdc++.so.6()(64bit)", Flags: 16384}, {Name: "libm.so.6()(64bit)", Flags: 16384}, {Name: "libgcc_s.so.1()(64bit)", Flags: 16384}, {Name: "libpthread.so.0()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "rpmlib(PayloadIsXz)", Flags: 16777226, EVR: "5.2-1"}}},
+		{Path: "/usr/lib/.build-id", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096, MTime: 1594133069, Inode: 2, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/.build-id/4c", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096, MTime: 1594133069, Inode: 3, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/.build-id/4c/1f16ab7e1bbbf8f56dfbf1a098b6e6a6a9bbe4", Mode: 0xa1ff, Digest: "", Size: 24, Username: "root", Groupname: "root", Flags: 4096, MTime: 1594133069, LinkTo: "../../../../usr/bin/node", Inode: 4, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/node_modules", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1594132914, Inode: 5, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/rpm/fileattrs/nodejs_native.attr", Mode: 0x81a4, Digest: "991876f5bd2383feac22a58397641096d62045629f6e107cb9d211e626d7e226", Size: 119, Username: "root", Groupname: "root", Flags: 0, MTime: 1594132499, Inode: 6, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/rpm/nodejs_native.req", Mode: 0x81ed, Digest: "2eb0511016eb87b70278b06c3af7ed39986ac3dc43cad8239060e69c764c3a07", Size: 70, Username: "root", Groupname: "root", Flags: 0, MTime: 1594132914, Inode: 7, Device: 1, Class: "POSIX shell script, ASCII text executable", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "/bin/sh", Flags: 16384}}},
+		{Path: "/usr/share/doc/nodejs", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1594133069, Inode: 8, Device: 1, Class: "directory"},
+		{Path: "/usr/share/doc/nodejs/AUTHORS", Mode: 0x81a4, Digest: "692b7cc46bbc9630c6e0d7b5692ea9dd970e5a578264813568fa30ec11e193ee", Size: 87615, Username: "root", Groupname: "root", Flags: 2, MTime: 1594132627, Inode: 9, Device: 1, Class: "UTF-8 Unicode text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/nodejs/CHANGELOG.md", Mode: 0x81a4, Digest: "994b0861c5f28d4a57101d746024333b12e09f3d6b825d04ea3f34f36efd0952", Size: 52452, Username: "root", Groupname: "root", Flags: 2, MTime: 1594132627, Inode: 10, Device: 1, Class: "HTML document, ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/nodejs/COLLABORATOR_GUIDE.md", Mode: 0x81a4, Digest: "17d2103cb77e34fe60ba703dfba90769ff8d6b3e9be75cad598d0286ee7b84bc", Size: 36320, Username: "root", Groupname: "root", Flags: 2, MTime: 1594132603, Inode: 11, Device: 1, Class: "UTF-8 Unicode text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/nodejs/GOVERNANCE.md", Mode: 0x81a4, Digest: "55e859154a49870e8a5b41ef216fedcc2a7d375b2f87167b25e30f408209226e", Size: 8676, Username: "root", Groupname: "root", Flags: 2, MTime: 1594132626, Inode: 12, Device: 1, Class: "exported SGML document, ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/nodejs/README.md", Mode: 0x81a4, Digest: "ff363d76470542a790a00688d377a0164e6ace34f87042f9bcdd23e78b5b0c26", Size: 26749, Username: "root", Groupname: "root", Flags: 2, MTime: 1594132626, Inode: 13, Device: 1, Class: "HTML document, UTF-8 Unicode text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/licenses/nodejs", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1594133069, Inode: 14, Device: 1, Class: "directory"},
+		{Path: "/usr/share/licenses/nodejs/LICENSE", Mode: 0x81a4, Digest: "2b0fe00a83916d0290c8531db25a827e18d01e7c4bf000e9a0f2e826604ba41e", Size: 68764, Username: "root", Groupname: "root", Flags: 128, MTime: 1594132626, Inode: 15, Device: 1, Class: "UTF-8 Unicode text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/node.1.gz", Mode: 0x81a4, Digest: "492c658669822df426eeeeffcd33572f9801f06ddfec2a5ab6ee622943d613ea", Size: 4849, Username: "root", Groupname: "root", Flags: 2, MTime: 1594132627, Inode: 16, Device: 1, Class: "troff or preprocessor input, ASCII text (gzip compressed data, max compression, from Unix)", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/node", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1594132914, Inode: 17, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/systemtap", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1594132914, Inode: 18, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/systemtap/tapset", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1594132914, Inode: 19, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/systemtap/tapset/node.stp", Mode: 0x81a4, Digest: "1df168860f170690b202271d7f604514e64831523db62e7bae3f9a85cbbd7bf4", Size: 3495, Username: "root", Groupname: "root", Flags: 0, MTime: 1594132603, Inode: 20, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
 	}
 
 	Mariner2CurlInstalledFiles = []FileInfo{
-		{Path: "/usr/bin/curl", Mode: 0x81ed, Digest: "7ef6b3fd854d4ed189dd0aab2ffcf5e6bd58018ff69b97e1b86e1df7b86f3df0", Size: 267672, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/bin/curl-config", Mode: 0x81ed, Digest: "f044fcec45baaaaa7f2de43646719812a1fc75eb3582ae4c9bfc2fd0aa873afc", Size: 7515, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/licenses/curl", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/licenses/curl/COPYING", Mode: 0x81a4, Digest: "6fd1a1c008b5ef4c4741dd188c3f8af6944c14c25afa881eb064f98fb98358e7", Size: 1088, Username: "root", Groupname: "root", Flags: 128},
-		{Path: "/usr/share/man/man1/curl-config.1.gz", Mode: 0x81a4, Digest: "e9346781b9d38f416138255182c0ff5818f09adaaf38f08abfd545fa149f77b6", Size: 1763, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/man/man1/curl.1.gz", Mode: 0x81a4, Digest: "6a937dea68ab20b8bf9d376bb68fcfe06608a4ddb7d7725986c7ad7451817912", Size: 47985, Username: "root", Groupname: "root", Flags: 2},
+		{Path: "/usr/bin/curl", Mode: 0x81ed, Digest: "7ef6b3fd854d4ed189dd0aab2ffcf5e6bd58018ff69b97e1b86e1df7b86f3df0", Size: 267672, Username: "root", Groupname: "root", Flags: 0, MTime: 1643075921, Inode: 1, Device: 1, Color: 2, Class: "ELF 64-bit LSB pie executable, x86-64, version 1 (SYSV), dynamically linked, interpreter /lib64/ld-linux-x86-64.so.2, BuildID[sha1]=c319ce80bfd4132ef2404203d0e3bfe3739e522c, for GNU/Linux 3.2.0, stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "libc.so.6(GLIBC_2.4)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.7)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.33)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.14)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.34)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.4)(64bit)", Flags: 16384}, {Name: "libcurl.so.4()(64bit)", Flags: 16384}, {Name: "libz.so.1()(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}}},
+		{Path: "/usr/bin/curl-config", Mode: 0x81ed, Digest: "f044fcec45baaaaa7f2de43646719812a1fc75eb3582ae4c9bfc2fd0aa873afc", Size: 7515, Username: "root", Groupname: "root", Flags: 0, MTime: 1643075919, Inode: 2, Device: 1, Class: "POSIX shell script, ASCII text executable, with very long lines (1483)", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "/bin/sh", Flags: 16384}}},
+		{Path: "/usr/share/licenses/curl", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1643075922, Inode: 3, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/licenses/curl/COPYING", Mode: 0x81a4, Digest: "6fd1a1c008b5ef4c4741dd188c3f8af6944c14c25afa881eb064f98fb98358e7", Size: 1088, Username: "root", Groupname: "root", Flags: 128, MTime: 1612478034, Inode: 4, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/curl-config.1.gz", Mode: 0x81a4, Digest: "e9346781b9d38f416138255182c0ff5818f09adaaf38f08abfd545fa149f77b6", Size: 1763, Username: "root", Groupname: "root", Flags: 2, MTime: 1643075919, Inode: 5, Device: 1, Class: "troff or preprocessor input, ASCII text (gzip compressed data, max compression, from Unix)", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/curl.1.gz", Mode: 0x81a4, Digest: "6a937dea68ab20b8bf9d376bb68fcfe06608a4ddb7d7725986c7ad7451817912", Size: 47985, Username: "root", Groupname: "root", Flags: 2, MTime: 1643075919, Inode: 6, Device: 1, Class: "troff or preprocessor input, ASCII text (gzip compressed data, max compression, from Unix)", VerifyFlags: RPMVERIFY_ALL},
 	}
 
 	LibuuidInstalledFiles = []FileInfo{
-		{Path: "/usr/lib/.build-id", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096},
-		{Path: "/usr/lib/.build-id/df/ef6a880adac817216ab9866779a0725e017647", Mode: 0xa1ff, Digest: "", Size: 38, Username: "root", Groupname: "root", Flags: 4096},
-		{Path: "/usr/lib64/libuuid.so.1", Mode: 0xa1ff, Digest: "", Size: 16, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib64/libuuid.so.1.3.0", Mode: 0x81ed, Digest: "3c8f59a0e39cce53501045859f5c9a86b82fedd558a2f4110ab1823047b43745", Size: 33496, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/licenses/libuuid", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/licenses/libuuid/COPYING", Mode: 0x81a4, Digest: "f051c5b2e7ed25acac4b79d22b9e5df80447c16f9ec543d6ed21c213c576f6aa", Size: 217, Username: "root", Groupname: "root", Flags: 128},
-		{Path: "/usr/share/licenses/libuuid/COPYING.BSD-3", Mode: 0x81a4, Digest: "9b718a9460fed5952466421235bc79eb49d4e9eacc920d7a9dd6285ab8fd6c6d", Size: 1391, Username: "root", Groupname: "root", Flags: 128},
+		{Path: "/usr/lib/.build-id", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096, MTime: 1680515720, Inode: 1, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/.build-id/df/ef6a880adac817216ab9866779a0725e017647", Mode: 0xa1ff, Digest: "", Size: 38, Username: "root", Groupname: "root", Flags: 4096, MTime: 1680515721, LinkTo: "../../../../usr/lib64/libuuid.so.1.3.0", Inode: 2, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib64/libuuid.so.1", Mode: 0xa1ff, Digest: "", Size: 16, Username: "root", Groupname: "root", Flags: 0, MTime: 1680515715, LinkTo: "libuuid.so.1.3.0", Inode: 3, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib64/libuuid.so.1.3.0", Mode: 0x81ed, Digest: "3c8f59a0e39cce53501045859f5c9a86b82fedd558a2f4110ab1823047b43745", Size: 33496, Username: "root", Groupname: "root", Flags: 0, MTime: 1680515719, Inode: 4, Device: 1, Color: 2, Class: "ELF 64-bit LSB shared object, x86-64, version 1 (SYSV), dynamically linked, BuildID[sha1]=dfef6a880adac817216ab9866779a0725e017647, stripped", VerifyFlags: RPMVERIFY_ALL, Provides: []Dependency{{Name: "libuuid.so.1(UUID_1.0)(64bit)", Flags: 32768}, {Name: "libuuid.so.1(UUID_2.20)(64bit)", Flags: 32768}, {Name: "libuuid.so.1(UUID_2.20)(64bit)", Flags: 32768}, {Name: "libuuid.so.1(UUID_2.31)(64bit)", Flags: 32768}, {Name: "libuuid.so.1(UUID_2.31)(64bit)", Flags: 32768}, {Name: "libuuid.so.1(UUIDD_PRIVATE)(64bit)", Flags: 32768}, {Name: "libuuid.so.1()(64bit)", Flags: 32768}}, Requires: []Dependency{{Name: "ld-linux-x86-64.so.2(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.25)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.3.4)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.28)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.14)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.4)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.2.5)(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "ld-linux-x86-64.so.2()(64bit)", Flags: 16384}, {Name: "rpmlib(PayloadIsXz)", Flags: 16777226, EVR: "5.2-1"}}},
+		{Path: "/usr/share/licenses/libuuid", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1680515721, Inode: 5, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/licenses/libuuid/COPYING", Mode: 0x81a4, Digest: "f051c5b2e7ed25acac4b79d22b9e5df80447c16f9ec543d6ed21c213c576f6aa", Size: 217, Username: "root", Groupname: "root", Flags: 128, MTime: 1505728083, Inode: 6, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/licenses/libuuid/COPYING.BSD-3", Mode: 0x81a4, Digest: "9b718a9460fed5952466421235bc79eb49d4e9eacc920d7a9dd6285ab8fd6c6d", Size: 1391, Username: "root", Groupname: "root", Flags: 128, MTime: 1505728083, Inode: 7, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
 	}
 
 	Rockylinux9HostnameFiles = []FileInfo{
-		{Path: "/usr/bin/dnsdomainname", Mode: 0xa1ff, Digest: "", Size: 8, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/bin/domainname", Mode: 0xa1ff, Digest: "", Size: 8, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/bin/hostname", Mode: 0x81ed, Digest: "94ef5f552485167bbb790ab79b0ffb8f1b7b5f6e99d3a2d6becfbe77115a22c5", Size: 69256, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/bin/nisdomainname", Mode: 0xa1ff, Digest: "", Size: 8, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/bin/ypdomainname", Mode: 0xa1ff, Digest: "", Size: 8, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/lib/.build-id", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096},
-		{Path: "/usr/lib/.build-id/32", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096},
-		{Path: "/usr/lib/.build-id/32/2d92d5e3853c3ef53bcbdee3666eff79eeca92", Mode: 0xa1ff, Digest: "", Size: 28, Username: "root", Groupname: "root", Flags: 4096},
-		{Path: "/usr/lib/systemd/system/nis-domainname.service", Mode: 0x81a4, Digest: "571aea8d633c0a9a08a453a387768cfa7e819c52a0cf6302036c0cc8711907ee", Size: 378, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/libexec/hostname", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/libexec/hostname/nis-domainname", Mode: 0x81ed, Digest: "e34871863f59e9f0eee4674217d11d4ae46fe20b6496170787f0e45170997a4a", Size: 166, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/doc/hostname", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/doc/hostname/COPYRIGHT", Mode: 0x81a4, Digest: "303020aa9550b292aac5980b98d8bbeb7c8162b044f6d3608b81d906ae67ed36", Size: 831, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/licenses/hostname", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0},
-		{Path: "/usr/share/licenses/hostname/gpl-2.0.txt", Mode: 0x81a4, Digest: "8177f97513213526df2cf6184d8ff986c675afb514d4e68a404010521b880643", Size: 18092, Username: "root", Groupname: "root", Flags: 128},
-		{Path: "/usr/share/man/man1/dnsdomainname.1.gz", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/man/man1/domainname.1.gz", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/man/man1/hostname.1.gz", Mode: 0x81a4, Digest: "e7472cf4dc265034adbb60c69912d55a67de4bcc3d0adfa21425e876ad9e18c8", Size: 2837, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/man/man1/nisdomainname.1.gz", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 2},
-		{Path: "/usr/share/man/man1/ypdomainname.1.gz", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 2},
+		{Path: "/usr/bin/dnsdomainname", Mode: 0xa1ff, Digest: "", Size: 8, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567982, LinkTo: "hostname", Inode: 1, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/bin/domainname", Mode: 0xa1ff, Digest: "", Size: 8, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567982, LinkTo: "hostname", Inode: 2, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/bin/hostname", Mode: 0x81ed, Digest: "94ef5f552485167bbb790ab79b0ffb8f1b7b5f6e99d3a2d6becfbe77115a22c5", Size: 69256, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567985, Inode: 3, Device: 1, Color: 2, Class: "ELF 64-bit LSB pie executable, ARM aarch64, version 1 (SYSV), dynamically linked, interpreter /lib/ld-linux-aarch64.so.1, BuildID[sha1]=322d92d5e3853c3ef53bcbdee3666eff79eeca92, for GNU/Linux 3.7.0, stripped", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "ld-linux-aarch64.so.1(GLIBC_2.17)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.33)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.34)(64bit)", Flags: 16384}, {Name: "libc.so.6(GLIBC_2.17)(64bit)", Flags: 16384}, {Name: "libc.so.6()(64bit)", Flags: 16384}, {Name: "ld-linux-aarch64.so.1()(64bit)", Flags: 16384}, {Name: "rpmlib(PayloadIsZstd)", Flags: 16777226, EVR: "5.4.18-1"}}},
+		{Path: "/usr/bin/nisdomainname", Mode: 0xa1ff, Digest: "", Size: 8, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567982, LinkTo: "hostname", Inode: 4, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/bin/ypdomainname", Mode: 0xa1ff, Digest: "", Size: 8, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567982, LinkTo: "hostname", Inode: 5, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/.build-id", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096, MTime: 1652567986, Inode: 6, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/.build-id/32", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 4096, MTime: 1652567986, Inode: 7, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/.build-id/32/2d92d5e3853c3ef53bcbdee3666eff79eeca92", Mode: 0xa1ff, Digest: "", Size: 28, Username: "root", Groupname: "root", Flags: 4096, MTime: 1652567986, LinkTo: "../../../../usr/bin/hostname", Inode: 8, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/lib/systemd/system/nis-domainname.service", Mode: 0x81a4, Digest: "571aea8d633c0a9a08a453a387768cfa7e819c52a0cf6302036c0cc8711907ee", Size: 378, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567982, Inode: 9, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/libexec/hostname", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567982, Inode: 10, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/libexec/hostname/nis-domainname", Mode: 0x81ed, Digest: "e34871863f59e9f0eee4674217d11d4ae46fe20b6496170787f0e45170997a4a", Size: 166, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567982, Inode: 11, Device: 1, Class: "Bourne-Again shell script, ASCII text executable", VerifyFlags: RPMVERIFY_ALL, Requires: []Dependency{{Name: "/usr/bin/bash", Flags: 16384}}},
+		{Path: "/usr/share/doc/hostname", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567986, Inode: 12, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/doc/hostname/COPYRIGHT", Mode: 0x81a4, Digest: "303020aa9550b292aac5980b98d8bbeb7c8162b044f6d3608b81d906ae67ed36", Size: 831, Username: "root", Groupname: "root", Flags: 2, MTime: 1517307942, Inode: 13, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/licenses/hostname", Mode: 0x41ed, Digest: "", Size: 0, Username: "root", Groupname: "root", Flags: 0, MTime: 1652567986, Inode: 14, Device: 1, Class: "directory", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/licenses/hostname/gpl-2.0.txt", Mode: 0x81a4, Digest: "8177f97513213526df2cf6184d8ff986c675afb514d4e68a404010521b880643", Size: 18092, Username: "root", Groupname: "root", Flags: 128, MTime: 1652567978, Inode: 15, Device: 1, Class: "ASCII text", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/dnsdomainname.1.gz", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 2, MTime: 1652567985, LinkTo: "hostname.1.gz", Inode: 16, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/domainname.1.gz", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 2, MTime: 1652567985, LinkTo: "hostname.1.gz", Inode: 17, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/hostname.1.gz", Mode: 0x81a4, Digest: "e7472cf4dc265034adbb60c69912d55a67de4bcc3d0adfa21425e876ad9e18c8", Size: 2837, Username: "root", Groupname: "root", Flags: 2, MTime: 1652567982, Inode: 18, Device: 1, Class: "troff or preprocessor input, ASCII text (gzip compressed data, max compression, from Unix)", VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/nisdomainname.1.gz", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 2, MTime: 1652567986, LinkTo: "hostname.1.gz", Inode: 19, Device: 1, VerifyFlags: RPMVERIFY_ALL},
+		{Path: "/usr/share/man/man1/ypdomainname.1.gz", Mode: 0xa1ff, Digest: "", Size: 13, Username: "root", Groupname: "root", Flags: 2, MTime: 1652567986, LinkTo: "hostname.1.gz", Inode: 20, Device: 1, VerifyFlags: RPMVERIFY_ALL},
 	}
 
 	Rockylinux9HostnameFileNames = []string{
@@ -1,47 +1,397 @@
 package rpmdb
 
+import (
+	"fmt"
+	"strings"
+)
+
 const (
-	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.3-release/lib/rpmtag.h#L34
-	RPMTAG_HEADERIMAGE      = 61
-	RPMTAG_HEADERSIGNATURES = 62
-	RPMTAG_HEADERIMMUTABLE  = 63
-	HEADER_I18NTABLE        = 100
-	RPMTAG_HEADERI18NTABLE  = HEADER_I18NTABLE
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/include/rpm/header.h
+	HEADER_IMAGE      = 61
+	HEADER_SIGNATURES = 62
+	HEADER_IMMUTABLE  = 63
+	HEADER_REGIONS    = 64
+	HEADER_I18NTABLE  = 100
+	HEADER_SIGBASE    = 256
+	HEADER_TAGBASE    = 1000
 
 	// rpmTag_e
-	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.3-release/lib/rpmtag.h#L34
-	RPMTAG_PGP            = 259  /* b */
-	RPMTAG_SIGMD5         = 261  /* x */
-	RPMTAG_DSAHEADER      = 267  /* x */
-	RPMTAG_RSAHEADER      = 268  /* x */
-	RPMTAG_SHA1HEADER     = 269  /* s */
-	RPMTAG_NAME           = 1000 /* s */
-	RPMTAG_VERSION        = 1001 /* s */
-	RPMTAG_RELEASE        = 1002 /* s */
-	RPMTAG_EPOCH          = 1003 /* i */
-	RPMTAG_INSTALLTIME    = 1008 /* i */
-	RPMTAG_SIZE           = 1009 /* i */
-	RPMTAG_VENDOR         = 1011 /* s */
-	RPMTAG_LICENSE        = 1014 /* s */
-	RPMTAG_ARCH           = 1022 /* s */
-	RPMTAG_FILESIZES      = 1028 /* i[] */
-	RPMTAG_FILEMODES      = 1030 /* h[] , specifically []uint16 (ref https://github.com/rpm-software-management/rpm/blob/2153fa4ae51a84547129b8ebb3bb396e1737020e/lib/rpmtypes.h#L53 )*/
-	RPMTAG_FILEDIGESTS    = 1035 /* s[] */
-	RPMTAG_FILEFLAGS      = 1037 /* i[] */
-	RPMTAG_FILEUSERNAME   = 1039 /* s[] */
-	RPMTAG_FILEGROUPNAME  = 1040 /* s[] */
-	RPMTAG_SOURCERPM      = 1044 /* s */
-	RPMTAG_PROVIDENAME    = 1047 /* s[] */
-	RPMTAG_REQUIRENAME    = 1049 /* s[] */
-	RPMTAG_DIRINDEXES     = 1116 /* i[] */
-	RPMTAG_BASENAMES      = 1117 /* s[] */
-	RPMTAG_DIRNAMES       = 1118 /* s[] */
-	RPMTAG_FILEDIGESTALGO = 5011 /* i  */
-	RPMTAG_SUMMARY        = 1004 /* s */
-
-	// rpmTag_enhances
-	// https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/rpmtag.h#L375
-	RPMTAG_MODULARITYLABEL = 5096
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/include/rpm/rpmtag.h
+	RPMTAG_HEADERIMAGE      = HEADER_IMAGE      /* x */
+	RPMTAG_HEADERSIGNATURES = HEADER_SIGNATURES /* x */
+	RPMTAG_HEADERIMMUTABLE  = HEADER_IMMUTABLE  /* x */
+	RPMTAG_HEADERREGIONS    = HEADER_REGIONS    /* x */
+	RPMTAG_HEADERI18NTABLE  = HEADER_I18NTABLE  /* s[] */
+
+	// signature tags retrofitted into the header tag space
+	RPMTAG_SIG_BASE            = HEADER_SIGBASE
+	RPMTAG_SIGSIZE             = 257 /* i */
+	RPMTAG_SIGLEMD5_1          = 258 /* x */
+	RPMTAG_SIGPGP              = 259 /* x */
+	RPMTAG_SIGLEMD5_2          = 260 /* x */
+	RPMTAG_SIGMD5              = 261 /* x */
+	RPMTAG_SIGGPG              = 262 /* x */
+	RPMTAG_SIGPGP5             = 263 /* x */
+	RPMTAG_BADSHA1_1           = 264 /* x */
+	RPMTAG_BADSHA1_2           = 265 /* x */
+	RPMTAG_PUBKEYS             = 266 /* s[] */
+	RPMTAG_DSAHEADER           = 267 /* x */
+	RPMTAG_RSAHEADER           = 268 /* x */
+	RPMTAG_SHA1HEADER          = 269 /* s */
+	RPMTAG_LONGSIGSIZE         = 270 /* l */
+	RPMTAG_LONGARCHIVESIZE     = 271 /* l */
+	RPMTAG_SHA256HEADER        = 273 /* s */
+	RPMTAG_VERITYSIGNATURES    = 276 /* s[] */
+	RPMTAG_VERITYSIGNATUREALGO = 277 /* i */
+	RPMTAG_OPENPGP             = 278 /* s[] */
+
+	RPMTAG_NAME               = 1000 /* s */
+	RPMTAG_VERSION            = 1001 /* s */
+	RPMTAG_RELEASE            = 1002 /* s */
+	RPMTAG_EPOCH              = 1003 /* i */
+	RPMTAG_SUMMARY            = 1004 /* s{} */
+	RPMTAG_DESCRIPTION        = 1005 /* s{} */
+	RPMTAG_BUILDTIME          = 1006 /* i */
+	RPMTAG_BUILDHOST          = 1007 /* s */
+	RPMTAG_INSTALLTIME        = 1008 /* i */
+	RPMTAG_SIZE               = 1009 /* i */
+	RPMTAG_DISTRIBUTION       = 1010 /* s */
+	RPMTAG_VENDOR             = 1011 /* s */
+	RPMTAG_GIF                = 1012 /* x */
+	RPMTAG_XPM                = 1013 /* x */
+	RPMTAG_LICENSE            = 1014 /* s */
+	RPMTAG_PACKAGER           = 1015 /* s */
+	RPMTAG_GROUP              = 1016 /* s{} */
+	RPMTAG_CHANGELOG          = 1017 /* s[] */
+	RPMTAG_SOURCE             = 1018 /* s[] */
+	RPMTAG_PATCH              = 1019 /* s[] */
+	RPMTAG_URL                = 1020 /* s */
+	RPMTAG_OS                 = 1021 /* s */
+	RPMTAG_ARCH               = 1022 /* s */
+	RPMTAG_PREIN              = 1023 /* s */
+	RPMTAG_POSTIN             = 1024 /* s */
+	RPMTAG_PREUN              = 1025 /* s */
+	RPMTAG_POSTUN             = 1026 /* s */
+	RPMTAG_OLDFILENAMES       = 1027 /* s[] */
+	RPMTAG_FILESIZES          = 1028 /* i[] */
+	RPMTAG_FILESTATES         = 1029 /* c[] */
+	RPMTAG_FILEMODES          = 1030 /* h[] , specifically []uint16 (ref https://github.com/rpm-software-management/rpm/blob/2153fa4ae51a84547129b8ebb3bb396e1737020e/lib/rpmtypes.h#L53 )*/
+	RPMTAG_FILEUIDS           = 1031 /* i[] */
+	RPMTAG_FILEGIDS           = 1032 /* i[] */
+	RPMTAG_FILERDEVS          = 1033 /* h[] */
+	RPMTAG_FILEMTIMES         = 1034 /* i[] */
+	RPMTAG_FILEDIGESTS        = 1035 /* s[] */
+	RPMTAG_FILELINKTOS        = 1036 /* s[] */
+	RPMTAG_FILEFLAGS          = 1037 /* i[] */
+	RPMTAG_ROOT               = 1038 /* internal */
+	RPMTAG_FILEUSERNAME       = 1039 /* s[] */
+	RPMTAG_FILEGROUPNAME      = 1040 /* s[] */
+	RPMTAG_EXCLUDE            = 1041 /* internal */
+	RPMTAG_EXCLUSIVE          = 1042 /* internal */
+	RPMTAG_ICON               = 1043 /* x */
+	RPMTAG_SOURCERPM          = 1044 /* s */
+	RPMTAG_FILEVERIFYFLAGS    = 1045 /* i[] */
+	RPMTAG_ARCHIVESIZE        = 1046 /* i */
+	RPMTAG_PROVIDENAME        = 1047 /* s[] */
+	RPMTAG_REQUIREFLAGS       = 1048 /* i[] */
+	RPMTAG_REQUIRENAME        = 1049 /* s[] */
+	RPMTAG_REQUIREVERSION     = 1050 /* s[] */
+	RPMTAG_NOSOURCE           = 1051 /* i[] */
+	RPMTAG_NOPATCH            = 1052 /* i[] */
+	RPMTAG_CONFLICTFLAGS      = 1053 /* i[] */
+	RPMTAG_CONFLICTNAME       = 1054 /* s[] */
+	RPMTAG_CONFLICTVERSION    = 1055 /* s[] */
+	RPMTAG_DEFAULTPREFIX      = 1056 /* s */
+	RPMTAG_BUILDROOT          = 1057 /* s */
+	RPMTAG_INSTALLPREFIX      = 1058 /* s */
+	RPMTAG_EXCLUDEARCH        = 1059 /* s[] */
+	RPMTAG_EXCLUDEOS          = 1060 /* s[] */
+	RPMTAG_EXCLUSIVEARCH      = 1061 /* s[] */
+	RPMTAG_EXCLUSIVEOS        = 1062 /* s[] */
+	RPMTAG_AUTOREQPROV        = 1063 /* s */
+	RPMTAG_RPMVERSION         = 1064 /* s */
+	RPMTAG_TRIGGERSCRIPTS     = 1065 /* s[] */
+	RPMTAG_TRIGGERNAME        = 1066 /* s[] */
+	RPMTAG_TRIGGERVERSION     = 1067 /* s[] */
+	RPMTAG_TRIGGERFLAGS       = 1068 /* i[] */
+	RPMTAG_TRIGGERINDEX       = 1069 /* i[] */
+	RPMTAG_VERIFYSCRIPT       = 1079 /* s */
+	RPMTAG_CHANGELOGTIME      = 1080 /* i[] */
+	RPMTAG_CHANGELOGNAME      = 1081 /* s[] */
+	RPMTAG_CHANGELOGTEXT      = 1082 /* s[] */
+	RPMTAG_BROKENMD5          = 1083 /* internal */
+	RPMTAG_PREREQ             = 1084 /* internal */
+	RPMTAG_PREINPROG          = 1085 /* s[] */
+	RPMTAG_POSTINPROG         = 1086 /* s[] */
+	RPMTAG_PREUNPROG          = 1087 /* s[] */
+	RPMTAG_POSTUNPROG         = 1088 /* s[] */
+	RPMTAG_BUILDARCHS         = 1089 /* s[] */
+	RPMTAG_OBSOLETENAME       = 1090 /* s[] */
+	RPMTAG_VERIFYSCRIPTPROG   = 1091 /* s[] */
+	RPMTAG_TRIGGERSCRIPTPROG  = 1092 /* s[] */
+	RPMTAG_DOCDIR             = 1093 /* internal */
+	RPMTAG_COOKIE             = 1094 /* s */
+	RPMTAG_FILEDEVICES        = 1095 /* i[] */
+	RPMTAG_FILEINODES         = 1096 /* i[] */
+	RPMTAG_FILELANGS          = 1097 /* s[] */
+	RPMTAG_PREFIXES           = 1098 /* s[] */
+	RPMTAG_INSTPREFIXES       = 1099 /* s[] */
+	RPMTAG_TRIGGERIN          = 1100 /* internal */
+	RPMTAG_TRIGGERUN          = 1101 /* internal */
+	RPMTAG_TRIGGERPOSTUN      = 1102 /* internal */
+	RPMTAG_AUTOREQ            = 1103 /* internal */
+	RPMTAG_AUTOPROV           = 1104 /* internal */
+	RPMTAG_CAPABILITY         = 1105 /* i */
+	RPMTAG_SOURCEPACKAGE      = 1106 /* i */
+	RPMTAG_OLDORIGFILENAMES   = 1107 /* internal */
+	RPMTAG_BUILDPREREQ        = 1108 /* internal */
+	RPMTAG_BUILDREQUIRES      = 1109 /* internal */
+	RPMTAG_BUILDCONFLICTS     = 1110 /* internal */
+	RPMTAG_BUILDMACROS        = 1111 /* internal */
+	RPMTAG_PROVIDEFLAGS       = 1112 /* i[] */
+	RPMTAG_PROVIDEVERSION     = 1113 /* s[] */
+	RPMTAG_OBSOLETEFLAGS      = 1114 /* i[] */
+	RPMTAG_OBSOLETEVERSION    = 1115 /* s[] */
+	RPMTAG_DIRINDEXES         = 1116 /* i[] */
+	RPMTAG_BASENAMES          = 1117 /* s[] */
+	RPMTAG_DIRNAMES           = 1118 /* s[] */
+	RPMTAG_ORIGDIRINDEXES     = 1119 /* i[] */
+	RPMTAG_ORIGBASENAMES      = 1120 /* s[] */
+	RPMTAG_ORIGDIRNAMES       = 1121 /* s[] */
+	RPMTAG_OPTFLAGS           = 1122 /* s */
+	RPMTAG_DISTURL            = 1123 /* s */
+	RPMTAG_PAYLOADFORMAT      = 1124 /* s */
+	RPMTAG_PAYLOADCOMPRESSOR  = 1125 /* s */
+	RPMTAG_PAYLOADFLAGS       = 1126 /* s */
+	RPMTAG_INSTALLCOLOR       = 1127 /* i */
+	RPMTAG_INSTALLTID         = 1128 /* i */
+	RPMTAG_REMOVETID          = 1129 /* i */
+	RPMTAG_SHA1RHN            = 1130 /* internal */
+	RPMTAG_RHNPLATFORM        = 1131 /* s */
+	RPMTAG_PLATFORM           = 1132 /* s */
+	RPMTAG_PATCHESNAME        = 1133 /* s[] */
+	RPMTAG_PATCHESFLAGS       = 1134 /* i[] */
+	RPMTAG_PATCHESVERSION     = 1135 /* s[] */
+	RPMTAG_CACHECTIME         = 1136 /* i */
+	RPMTAG_CACHEPKGPATH       = 1137 /* s */
+	RPMTAG_CACHEPKGSIZE       = 1138 /* i */
+	RPMTAG_CACHEPKGMTIME      = 1139 /* i */
+	RPMTAG_FILECOLORS         = 1140 /* i[] */
+	RPMTAG_FILECLASS          = 1141 /* i[] */
+	RPMTAG_CLASSDICT          = 1142 /* s[] */
+	RPMTAG_FILEDEPENDSX       = 1143 /* i[] */
+	RPMTAG_FILEDEPENDSN       = 1144 /* i[] */
+	RPMTAG_DEPENDSDICT        = 1145 /* i[] */
+	RPMTAG_SOURCEPKGID        = 1146 /* x */
+	RPMTAG_FILECONTEXTS       = 1147 /* s[] */
+	RPMTAG_FSCONTEXTS         = 1148 /* s[] */
+	RPMTAG_RECONTEXTS         = 1149 /* s[] */
+	RPMTAG_POLICIES           = 1150 /* s[] */
+	RPMTAG_PRETRANS           = 1151 /* s */
+	RPMTAG_POSTTRANS          = 1152 /* s */
+	RPMTAG_PRETRANSPROG       = 1153 /* s[] */
+	RPMTAG_POSTTRANSPROG      = 1154 /* s[] */
+	RPMTAG_DISTTAG            = 1155 /* s */
+	RPMTAG_OLDSUGGESTSNAME    = 1156 /* s[] */
+	RPMTAG_OLDSUGGESTSVERSION = 1157 /* s[] */
+	RPMTAG_OLDSUGGESTSFLAGS   = 1158 /* i[] */
+	RPMTAG_OLDENHANCESNAME    = 1159 /* s[] */
+	RPMTAG_OLDENHANCESVERSION = 1160 /* s[] */
+	RPMTAG_OLDENHANCESFLAGS   = 1161 /* i[] */
+	RPMTAG_PRIORITY           = 1162 /* i[] */
+	RPMTAG_RPMLIBVERSION      = 1163 /* i */
+	RPMTAG_RPMLIBTIMESTAMP    = 1164 /* i */
+	RPMTAG_RPMLIBVENDOR       = 1165 /* i */
+	RPMTAG_CVSID              = 1166 /* s */
+	RPMTAG_BLINKPKGID         = 1167 /* s[] */
+	RPMTAG_BLINKHDRID         = 1168 /* s[] */
+	RPMTAG_BLINKNEVRA         = 1169 /* s[] */
+	RPMTAG_FLINKPKGID         = 1170 /* s[] */
+	RPMTAG_FLINKHDRID         = 1171 /* s[] */
+	RPMTAG_FLINKNEVRA         = 1172 /* s[] */
+	RPMTAG_PACKAGEORIGIN      = 1173 /* s */
+	RPMTAG_TRIGGERPREIN       = 1174 /* internal */
+	RPMTAG_BUILDSUGGESTS      = 1175 /* internal */
+	RPMTAG_BUILDENHANCES      = 1176 /* internal */
+	RPMTAG_SCRIPTSTATES       = 1177 /* i[] */
+	RPMTAG_SCRIPTMETRICS      = 1178 /* i[] */
+	RPMTAG_BUILDCPUCLOCK      = 1179 /* i */
+	RPMTAG_FILEDIGESTALGOS    = 1180 /* i[] */
+	RPMTAG_VARIANTS           = 1181 /* s[] */
+	RPMTAG_XMAJOR             = 1182 /* i */
+	RPMTAG_XMINOR             = 1183 /* i */
+	RPMTAG_REPOTAG            = 1184 /* s */
+	RPMTAG_KEYWORDS           = 1185 /* s[] */
+	RPMTAG_BUILDPLATFORMS     = 1186 /* s[] */
+	RPMTAG_PACKAGECOLOR       = 1187 /* i */
+	RPMTAG_PACKAGEPREFCOLOR   = 1188 /* i */
+	RPMTAG_XATTRSDICT         = 1189 /* s[] */
+	RPMTAG_FILEXATTRSX        = 1190 /* i[] */
+	RPMTAG_DEPATTRSDICT       = 1191 /* s[] */
+	RPMTAG_CONFLICTATTRSX     = 1192 /* i[] */
+	RPMTAG_OBSOLETEATTRSX     = 1193 /* i[] */
+	RPMTAG_PROVIDEATTRSX      = 1194 /* i[] */
+	RPMTAG_REQUIREATTRSX      = 1195 /* i[] */
+	RPMTAG_BUILDPROVIDES      = 1196 /* internal */
+	RPMTAG_BUILDOBSOLETES     = 1197 /* internal */
+	RPMTAG_DBINSTANCE         = 1198 /* i */
+	RPMTAG_NVRA               = 1199 /* s */
+
+	// tags 1997-4999 reserved
+	RPMTAG_FILENAMES                   = 5000 /* s[] */
+	RPMTAG_FILEPROVIDE                 = 5001 /* s[] */
+	RPMTAG_FILEREQUIRE                 = 5002 /* s[] */
+	RPMTAG_FSNAMES                     = 5003 /* s[] */
+	RPMTAG_FSSIZES                     = 5004 /* l[] */
+	RPMTAG_TRIGGERCONDS                = 5005 /* s[] */
+	RPMTAG_TRIGGERTYPE                 = 5006 /* s[] */
+	RPMTAG_ORIGFILENAMES               = 5007 /* s[] */
+	RPMTAG_LONGFILESIZES               = 5008 /* l[] */
+	RPMTAG_LONGSIZE                    = 5009 /* l */
+	RPMTAG_FILECAPS                    = 5010 /* s[] */
+	RPMTAG_FILEDIGESTALGO              = 5011 /* i */
+	RPMTAG_BUGURL                      = 5012 /* s */
+	RPMTAG_EVR                         = 5013 /* s */
+	RPMTAG_NVR                         = 5014 /* s */
+	RPMTAG_NEVR                        = 5015 /* s */
+	RPMTAG_NEVRA                       = 5016 /* s */
+	RPMTAG_HEADERCOLOR                 = 5017 /* i */
+	RPMTAG_VERBOSE                     = 5018 /* i */
+	RPMTAG_EPOCHNUM                    = 5019 /* i */
+	RPMTAG_PREINFLAGS                  = 5020 /* i */
+	RPMTAG_POSTINFLAGS                 = 5021 /* i */
+	RPMTAG_PREUNFLAGS                  = 5022 /* i */
+	RPMTAG_POSTUNFLAGS                 = 5023 /* i */
+	RPMTAG_PRETRANSFLAGS               = 5024 /* i */
+	RPMTAG_POSTTRANSFLAGS              = 5025 /* i */
+	RPMTAG_VERIFYSCRIPTFLAGS           = 5026 /* i */
+	RPMTAG_TRIGGERSCRIPTFLAGS          = 5027 /* i[] */
+	RPMTAG_COLLECTIONS                 = 5029 /* s[] */
+	RPMTAG_POLICYNAMES                 = 5030 /* s[] */
+	RPMTAG_POLICYTYPES                 = 5031 /* s[] */
+	RPMTAG_POLICYTYPESINDEXES          = 5032 /* i[] */
+	RPMTAG_POLICYFLAGS                 = 5033 /* i[] */
+	RPMTAG_VCS                         = 5034 /* s */
+	RPMTAG_ORDERNAME                   = 5035 /* s[] */
+	RPMTAG_ORDERVERSION                = 5036 /* s[] */
+	RPMTAG_ORDERFLAGS                  = 5037 /* i[] */
+	RPMTAG_MSSFMANIFEST                = 5038 /* s[] */
+	RPMTAG_MSSFDOMAIN                  = 5039 /* s[] */
+	RPMTAG_INSTFILENAMES               = 5040 /* s[] */
+	RPMTAG_REQUIRENEVRS                = 5041 /* s[] */
+	RPMTAG_PROVIDENEVRS                = 5042 /* s[] */
+	RPMTAG_OBSOLETENEVRS               = 5043 /* s[] */
+	RPMTAG_CONFLICTNEVRS               = 5044 /* s[] */
+	RPMTAG_FILENLINKS                  = 5045 /* i[] */
+	RPMTAG_RECOMMENDNAME               = 5046 /* s[] */
+	RPMTAG_RECOMMENDVERSION            = 5047 /* s[] */
+	RPMTAG_RECOMMENDFLAGS              = 5048 /* i[] */
+	RPMTAG_SUGGESTNAME                 = 5049 /* s[] */
+	RPMTAG_SUGGESTVERSION              = 5050 /* s[] */
+	RPMTAG_SUGGESTFLAGS                = 5051 /* i[] */
+	RPMTAG_SUPPLEMENTNAME              = 5052 /* s[] */
+	RPMTAG_SUPPLEMENTVERSION           = 5053 /* s[] */
+	RPMTAG_SUPPLEMENTFLAGS             = 5054 /* i[] */
+	RPMTAG_ENHANCENAME                 = 5055 /* s[] */
+	RPMTAG_ENHANCEVERSION              = 5056 /* s[] */
+	RPMTAG_ENHANCEFLAGS                = 5057 /* i[] */
+	RPMTAG_RECOMMENDNEVRS              = 5058 /* s[] */
+	RPMTAG_SUGGESTNEVRS                = 5059 /* s[] */
+	RPMTAG_SUPPLEMENTNEVRS             = 5060 /* s[] */
+	RPMTAG_ENHANCENEVRS                = 5061 /* s[] */
+	RPMTAG_ENCODING                    = 5062 /* s */
+	RPMTAG_FILETRIGGERIN               = 5063 /* internal */
+	RPMTAG_FILETRIGGERUN               = 5064 /* internal */
+	RPMTAG_FILETRIGGERPOSTUN           = 5065 /* internal */
+	RPMTAG_FILETRIGGERSCRIPTS          = 5066 /* s[] */
+	RPMTAG_FILETRIGGERSCRIPTPROG       = 5067 /* s[] */
+	RPMTAG_FILETRIGGERSCRIPTFLAGS      = 5068 /* i[] */
+	RPMTAG_FILETRIGGERNAME             = 5069 /* s[] */
+	RPMTAG_FILETRIGGERINDEX            = 5070 /* i[] */
+	RPMTAG_FILETRIGGERVERSION          = 5071 /* s[] */
+	RPMTAG_FILETRIGGERFLAGS            = 5072 /* i[] */
+	RPMTAG_TRANSFILETRIGGERIN          = 5073 /* internal */
+	RPMTAG_TRANSFILETRIGGERUN          = 5074 /* internal */
+	RPMTAG_TRANSFILETRIGGERPOSTUN      = 5075 /* internal */
+	RPMTAG_TRANSFILETRIGGERSCRIPTS     = 5076 /* s[] */
+	RPMTAG_TRANSFILETRIGGERSCRIPTPROG  = 5077 /* s[] */
+	RPMTAG_TRANSFILETRIGGERSCRIPTFLAGS = 5078 /* i[] */
+	RPMTAG_TRANSFILETRIGGERNAME        = 5079 /* s[] */
+	RPMTAG_TRANSFILETRIGGERINDEX       = 5080 /* i[] */
+	RPMTAG_TRANSFILETRIGGERVERSION     = 5081 /* s[] */
+	RPMTAG_TRANSFILETRIGGERFLAGS       = 5082 /* i[] */
+	RPMTAG_REMOVEPATHPOSTFIXES         = 5083 /* s */
+	RPMTAG_FILETRIGGERPRIORITIES       = 5084 /* i[] */
+	RPMTAG_TRANSFILETRIGGERPRIORITIES  = 5085 /* i[] */
+	RPMTAG_FILETRIGGERCONDS            = 5086 /* s[] */
+	RPMTAG_FILETRIGGERTYPE             = 5087 /* s[] */
+	RPMTAG_TRANSFILETRIGGERCONDS       = 5088 /* s[] */
+	RPMTAG_TRANSFILETRIGGERTYPE        = 5089 /* s[] */
+	RPMTAG_FILESIGNATURES              = 5090 /* s[] */
+	RPMTAG_FILESIGNATURELENGTH         = 5091 /* i */
+	RPMTAG_PAYLOADDIGEST               = 5092 /* s[] */
+	RPMTAG_PAYLOADDIGESTALGO           = 5093 /* i */
+	RPMTAG_AUTOINSTALLED               = 5094 /* i */
+	RPMTAG_IDENTITY                    = 5095 /* s */
+	RPMTAG_MODULARITYLABEL             = 5096 /* s */
+	RPMTAG_PAYLOADDIGESTALT            = 5097 /* s[] */
+	RPMTAG_ARCHSUFFIX                  = 5098 /* s */
+	RPMTAG_SPEC                        = 5099 /* s */
+	RPMTAG_TRANSLATIONURL              = 5100 /* s */
+	RPMTAG_UPSTREAMRELEASES            = 5101 /* s */
+	RPMTAG_SOURCELICENSE               = 5102 /* internal */
+	RPMTAG_PREUNTRANS                  = 5103 /* s */
+	RPMTAG_POSTUNTRANS                 = 5104 /* s */
+	RPMTAG_PREUNTRANSPROG              = 5105 /* s[] */
+	RPMTAG_POSTUNTRANSPROG             = 5106 /* s[] */
+	RPMTAG_PREUNTRANSFLAGS             = 5107 /* i */
+	RPMTAG_POSTUNTRANSFLAGS            = 5108 /* i */
+	RPMTAG_SYSUSERS                    = 5109 /* s[] */
+
+	// aliases
+	RPMTAG_PGP         = RPMTAG_SIGPGP // kept for compatibility
+	RPMTAG_PKGID       = RPMTAG_SIGMD5
+	RPMTAG_HDRID       = RPMTAG_SHA1HEADER
+	RPMTAG_N           = RPMTAG_NAME
+	RPMTAG_FILEMD5S    = RPMTAG_FILEDIGESTS
+	RPMTAG_PROVIDES    = RPMTAG_PROVIDENAME
+	RPMTAG_P           = RPMTAG_PROVIDENAME
+	RPMTAG_REQUIRES    = RPMTAG_REQUIRENAME
+	RPMTAG_CONFLICTS   = RPMTAG_CONFLICTNAME
+	RPMTAG_C           = RPMTAG_CONFLICTNAME
+	RPMTAG_OBSOLETES   = RPMTAG_OBSOLETENAME
+	RPMTAG_O           = RPMTAG_OBSOLETENAME
+	RPMTAG_SVNID       = RPMTAG_CVSID
+	RPMTAG_OLDSUGGESTS = RPMTAG_OLDSUGGESTSNAME
+	RPMTAG_OLDENHANCES = RPMTAG_OLDENHANCESNAME
+	RPMTAG_RECOMMENDS  = RPMTAG_RECOMMENDNAME
+	RPMTAG_SUGGESTS    = RPMTAG_SUGGESTNAME
+	RPMTAG_SUPPLEMENTS = RPMTAG_SUPPLEMENTNAME
+	RPMTAG_ENHANCES    = RPMTAG_ENHANCENAME
+
+	// rpmSigTag_e, the tags of the signature header
+	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/include/rpm/rpmtag.h
+	RPMSIGTAG_SIZE                = 1000
+	RPMSIGTAG_LEMD5_1             = 1001
+	RPMSIGTAG_PGP                 = 1002
+	RPMSIGTAG_LEMD5_2             = 1003
+	RPMSIGTAG_MD5                 = 1004
+	RPMSIGTAG_GPG                 = 1005
+	RPMSIGTAG_PGP5                = 1006
+	RPMSIGTAG_PAYLOADSIZE         = 1007
+	RPMSIGTAG_RESERVEDSPACE       = 1008
+	RPMSIGTAG_BADSHA1_1           = RPMTAG_BADSHA1_1
+	RPMSIGTAG_BADSHA1_2           = RPMTAG_BADSHA1_2
+	RPMSIGTAG_DSA                 = RPMTAG_DSAHEADER
+	RPMSIGTAG_RSA                 = RPMTAG_RSAHEADER
+	RPMSIGTAG_SHA1                = RPMTAG_SHA1HEADER
+	RPMSIGTAG_LONGSIZE            = RPMTAG_LONGSIGSIZE
+	RPMSIGTAG_LONGARCHIVESIZE     = RPMTAG_LONGARCHIVESIZE
+	RPMSIGTAG_SHA256              = RPMTAG_SHA256HEADER
+	RPMSIGTAG_FILESIGNATURES      = RPMTAG_SIG_BASE + 18
+	RPMSIGTAG_FILESIGNATURELENGTH = RPMTAG_SIG_BASE + 19
+	RPMSIGTAG_VERITYSIGNATURES    = RPMTAG_VERITYSIGNATURES
+	RPMSIGTAG_VERITYSIGNATUREALGO = RPMTAG_VERITYSIGNATUREALGO
 
 	// rpmTagType_e
 	// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.14.3-release/lib/rpmtag.h#L431
@@ -58,3 +408,410 @@ const (
 	RPM_I18NSTRING_TYPE   = 9
 	RPM_MAX_TYPE          = 9
 )
+
+type tagInfo struct {
+	tag  int32
+	name string
+	typ  uint32 // RPM_NULL_TYPE for internal tags that are never stored in a header
+}
+
+// tagTable mirrors the tag table rpm generates from rpmtag.h.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.19.0-release/lib/gentagtbl.sh
+var tagTable = []tagInfo{
+	{RPMTAG_HEADERIMAGE, "HEADERIMAGE", RPM_BIN_TYPE},
+	{RPMTAG_HEADERSIGNATURES, "HEADERSIGNATURES", RPM_BIN_TYPE},
+	{RPMTAG_HEADERIMMUTABLE, "HEADERIMMUTABLE", RPM_BIN_TYPE},
+	{RPMTAG_HEADERREGIONS, "HEADERREGIONS", RPM_BIN_TYPE},
+	{RPMTAG_HEADERI18NTABLE, "HEADERI18NTABLE", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_SIGSIZE, "SIGSIZE", RPM_INT32_TYPE},
+	{RPMTAG_SIGLEMD5_1, "SIGLEMD5_1", RPM_BIN_TYPE},
+	{RPMTAG_SIGPGP, "SIGPGP", RPM_BIN_TYPE},
+	{RPMTAG_SIGLEMD5_2, "SIGLEMD5_2", RPM_BIN_TYPE},
+	{RPMTAG_SIGMD5, "SIGMD5", RPM_BIN_TYPE},
+	{RPMTAG_SIGGPG, "SIGGPG", RPM_BIN_TYPE},
+	{RPMTAG_SIGPGP5, "SIGPGP5", RPM_BIN_TYPE},
+	{RPMTAG_BADSHA1_1, "BADSHA1_1", RPM_BIN_TYPE},
+	{RPMTAG_BADSHA1_2, "BADSHA1_2", RPM_BIN_TYPE},
+	{RPMTAG_PUBKEYS, "PUBKEYS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_DSAHEADER, "DSAHEADER", RPM_BIN_TYPE},
+	{RPMTAG_RSAHEADER, "RSAHEADER", RPM_BIN_TYPE},
+	{RPMTAG_SHA1HEADER, "SHA1HEADER", RPM_STRING_TYPE},
+	{RPMTAG_LONGSIGSIZE, "LONGSIGSIZE", RPM_INT64_TYPE},
+	{RPMTAG_LONGARCHIVESIZE, "LONGARCHIVESIZE", RPM_INT64_TYPE},
+	{RPMTAG_SHA256HEADER, "SHA256HEADER", RPM_STRING_TYPE},
+	{RPMTAG_VERITYSIGNATURES, "VERITYSIGNATURES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_VERITYSIGNATUREALGO, "VERITYSIGNATUREALGO", RPM_INT32_TYPE},
+	{RPMTAG_OPENPGP, "OPENPGP", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_NAME, "NAME", RPM_STRING_TYPE},
+	{RPMTAG_VERSION, "VERSION", RPM_STRING_TYPE},
+	{RPMTAG_RELEASE, "RELEASE", RPM_STRING_TYPE},
+	{RPMTAG_EPOCH, "EPOCH", RPM_INT32_TYPE},
+	{RPMTAG_SUMMARY, "SUMMARY", RPM_I18NSTRING_TYPE},
+	{RPMTAG_DESCRIPTION, "DESCRIPTION", RPM_I18NSTRING_TYPE},
+	{RPMTAG_BUILDTIME, "BUILDTIME", RPM_INT32_TYPE},
+	{RPMTAG_BUILDHOST, "BUILDHOST", RPM_STRING_TYPE},
+	{RPMTAG_INSTALLTIME, "INSTALLTIME", RPM_INT32_TYPE},
+	{RPMTAG_SIZE, "SIZE", RPM_INT32_TYPE},
+	{RPMTAG_DISTRIBUTION, "DISTRIBUTION", RPM_STRING_TYPE},
+	{RPMTAG_VENDOR, "VENDOR", RPM_STRING_TYPE},
+	{RPMTAG_GIF, "GIF", RPM_BIN_TYPE},
+	{RPMTAG_XPM, "XPM", RPM_BIN_TYPE},
+	{RPMTAG_LICENSE, "LICENSE", RPM_STRING_TYPE},
+	{RPMTAG_PACKAGER, "PACKAGER", RPM_STRING_TYPE},
+	{RPMTAG_GROUP, "GROUP", RPM_I18NSTRING_TYPE},
+	{RPMTAG_CHANGELOG, "CHANGELOG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_SOURCE, "SOURCE", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PATCH, "PATCH", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_URL, "URL", RPM_STRING_TYPE},
+	{RPMTAG_OS, "OS", RPM_STRING_TYPE},
+	{RPMTAG_ARCH, "ARCH", RPM_STRING_TYPE},
+	{RPMTAG_PREIN, "PREIN", RPM_STRING_TYPE},
+	{RPMTAG_POSTIN, "POSTIN", RPM_STRING_TYPE},
+	{RPMTAG_PREUN, "PREUN", RPM_STRING_TYPE},
+	{RPMTAG_POSTUN, "POSTUN", RPM_STRING_TYPE},
+	{RPMTAG_OLDFILENAMES, "OLDFILENAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILESIZES, "FILESIZES", RPM_INT32_TYPE},
+	{RPMTAG_FILESTATES, "FILESTATES", RPM_CHAR_TYPE},
+	{RPMTAG_FILEMODES, "FILEMODES", RPM_INT16_TYPE},
+	{RPMTAG_FILEUIDS, "FILEUIDS", RPM_INT32_TYPE},
+	{RPMTAG_FILEGIDS, "FILEGIDS", RPM_INT32_TYPE},
+	{RPMTAG_FILERDEVS, "FILERDEVS", RPM_INT16_TYPE},
+	{RPMTAG_FILEMTIMES, "FILEMTIMES", RPM_INT32_TYPE},
+	{RPMTAG_FILEDIGESTS, "FILEDIGESTS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILELINKTOS, "FILELINKTOS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILEFLAGS, "FILEFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_ROOT, "ROOT", RPM_NULL_TYPE},
+	{RPMTAG_FILEUSERNAME, "FILEUSERNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILEGROUPNAME, "FILEGROUPNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_EXCLUDE, "EXCLUDE", RPM_NULL_TYPE},
+	{RPMTAG_EXCLUSIVE, "EXCLUSIVE", RPM_NULL_TYPE},
+	{RPMTAG_ICON, "ICON", RPM_BIN_TYPE},
+	{RPMTAG_SOURCERPM, "SOURCERPM", RPM_STRING_TYPE},
+	{RPMTAG_FILEVERIFYFLAGS, "FILEVERIFYFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_ARCHIVESIZE, "ARCHIVESIZE", RPM_INT32_TYPE},
+	{RPMTAG_PROVIDENAME, "PROVIDENAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_REQUIREFLAGS, "REQUIREFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_REQUIRENAME, "REQUIRENAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_REQUIREVERSION, "REQUIREVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_NOSOURCE, "NOSOURCE", RPM_INT32_TYPE},
+	{RPMTAG_NOPATCH, "NOPATCH", RPM_INT32_TYPE},
+	{RPMTAG_CONFLICTFLAGS, "CONFLICTFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_CONFLICTNAME, "CONFLICTNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_CONFLICTVERSION, "CONFLICTVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_DEFAULTPREFIX, "DEFAULTPREFIX", RPM_STRING_TYPE},
+	{RPMTAG_BUILDROOT, "BUILDROOT", RPM_STRING_TYPE},
+	{RPMTAG_INSTALLPREFIX, "INSTALLPREFIX", RPM_STRING_TYPE},
+	{RPMTAG_EXCLUDEARCH, "EXCLUDEARCH", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_EXCLUDEOS, "EXCLUDEOS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_EXCLUSIVEARCH, "EXCLUSIVEARCH", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_EXCLUSIVEOS, "EXCLUSIVEOS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_AUTOREQPROV, "AUTOREQPROV", RPM_STRING_TYPE},
+	{RPMTAG_RPMVERSION, "RPMVERSION", RPM_STRING_TYPE},
+	{RPMTAG_TRIGGERSCRIPTS, "TRIGGERSCRIPTS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRIGGERNAME, "TRIGGERNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRIGGERVERSION, "TRIGGERVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRIGGERFLAGS, "TRIGGERFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_TRIGGERINDEX, "TRIGGERINDEX", RPM_INT32_TYPE},
+	{RPMTAG_VERIFYSCRIPT, "VERIFYSCRIPT", RPM_STRING_TYPE},
+	{RPMTAG_CHANGELOGTIME, "CHANGELOGTIME", RPM_INT32_TYPE},
+	{RPMTAG_CHANGELOGNAME, "CHANGELOGNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_CHANGELOGTEXT, "CHANGELOGTEXT", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_BROKENMD5, "BROKENMD5", RPM_NULL_TYPE},
+	{RPMTAG_PREREQ, "PREREQ", RPM_NULL_TYPE},
+	{RPMTAG_PREINPROG, "PREINPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_POSTINPROG, "POSTINPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PREUNPROG, "PREUNPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_POSTUNPROG, "POSTUNPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_BUILDARCHS, "BUILDARCHS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_OBSOLETENAME, "OBSOLETENAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_VERIFYSCRIPTPROG, "VERIFYSCRIPTPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRIGGERSCRIPTPROG, "TRIGGERSCRIPTPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_DOCDIR, "DOCDIR", RPM_NULL_TYPE},
+	{RPMTAG_COOKIE, "COOKIE", RPM_STRING_TYPE},
+	{RPMTAG_FILEDEVICES, "FILEDEVICES", RPM_INT32_TYPE},
+	{RPMTAG_FILEINODES, "FILEINODES", RPM_INT32_TYPE},
+	{RPMTAG_FILELANGS, "FILELANGS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PREFIXES, "PREFIXES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_INSTPREFIXES, "INSTPREFIXES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRIGGERIN, "TRIGGERIN", RPM_NULL_TYPE},
+	{RPMTAG_TRIGGERUN, "TRIGGERUN", RPM_NULL_TYPE},
+	{RPMTAG_TRIGGERPOSTUN, "TRIGGERPOSTUN", RPM_NULL_TYPE},
+	{RPMTAG_AUTOREQ, "AUTOREQ", RPM_NULL_TYPE},
+	{RPMTAG_AUTOPROV, "AUTOPROV", RPM_NULL_TYPE},
+	{RPMTAG_CAPABILITY, "CAPABILITY", RPM_INT32_TYPE},
+	{RPMTAG_SOURCEPACKAGE, "SOURCEPACKAGE", RPM_INT32_TYPE},
+	{RPMTAG_OLDORIGFILENAMES, "OLDORIGFILENAMES", RPM_NULL_TYPE},
+	{RPMTAG_BUILDPREREQ, "BUILDPREREQ", RPM_NULL_TYPE},
+	{RPMTAG_BUILDREQUIRES, "BUILDREQUIRES", RPM_NULL_TYPE},
+	{RPMTAG_BUILDCONFLICTS, "BUILDCONFLICTS", RPM_NULL_TYPE},
+	{RPMTAG_BUILDMACROS, "BUILDMACROS", RPM_NULL_TYPE},
+	{RPMTAG_PROVIDEFLAGS, "PROVIDEFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_PROVIDEVERSION, "PROVIDEVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_OBSOLETEFLAGS, "OBSOLETEFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_OBSOLETEVERSION, "OBSOLETEVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_DIRINDEXES, "DIRINDEXES", RPM_INT32_TYPE},
+	{RPMTAG_BASENAMES, "BASENAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_DIRNAMES, "DIRNAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ORIGDIRINDEXES, "ORIGDIRINDEXES", RPM_INT32_TYPE},
+	{RPMTAG_ORIGBASENAMES, "ORIGBASENAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ORIGDIRNAMES, "ORIGDIRNAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_OPTFLAGS, "OPTFLAGS", RPM_STRING_TYPE},
+	{RPMTAG_DISTURL, "DISTURL", RPM_STRING_TYPE},
+	{RPMTAG_PAYLOADFORMAT, "PAYLOADFORMAT", RPM_STRING_TYPE},
+	{RPMTAG_PAYLOADCOMPRESSOR, "PAYLOADCOMPRESSOR", RPM_STRING_TYPE},
+	{RPMTAG_PAYLOADFLAGS, "PAYLOADFLAGS", RPM_STRING_TYPE},
+	{RPMTAG_INSTALLCOLOR, "INSTALLCOLOR", RPM_INT32_TYPE},
+	{RPMTAG_INSTALLTID, "INSTALLTID", RPM_INT32_TYPE},
+	{RPMTAG_REMOVETID, "REMOVETID", RPM_INT32_TYPE},
+	{RPMTAG_SHA1RHN, "SHA1RHN", RPM_NULL_TYPE},
+	{RPMTAG_RHNPLATFORM, "RHNPLATFORM", RPM_STRING_TYPE},
+	{RPMTAG_PLATFORM, "PLATFORM", RPM_STRING_TYPE},
+	{RPMTAG_PATCHESNAME, "PATCHESNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PATCHESFLAGS, "PATCHESFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_PATCHESVERSION, "PATCHESVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_CACHECTIME, "CACHECTIME", RPM_INT32_TYPE},
+	{RPMTAG_CACHEPKGPATH, "CACHEPKGPATH", RPM_STRING_TYPE},
+	{RPMTAG_CACHEPKGSIZE, "CACHEPKGSIZE", RPM_INT32_TYPE},
+	{RPMTAG_CACHEPKGMTIME, "CACHEPKGMTIME", RPM_INT32_TYPE},
+	{RPMTAG_FILECOLORS, "FILECOLORS", RPM_INT32_TYPE},
+	{RPMTAG_FILECLASS, "FILECLASS", RPM_INT32_TYPE},
+	{RPMTAG_CLASSDICT, "CLASSDICT", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILEDEPENDSX, "FILEDEPENDSX", RPM_INT32_TYPE},
+	{RPMTAG_FILEDEPENDSN, "FILEDEPENDSN", RPM_INT32_TYPE},
+	{RPMTAG_DEPENDSDICT, "DEPENDSDICT", RPM_INT32_TYPE},
+	{RPMTAG_SOURCEPKGID, "SOURCEPKGID", RPM_BIN_TYPE},
+	{RPMTAG_FILECONTEXTS, "FILECONTEXTS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FSCONTEXTS, "FSCONTEXTS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_RECONTEXTS, "RECONTEXTS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_POLICIES, "POLICIES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PRETRANS, "PRETRANS", RPM_STRING_TYPE},
+	{RPMTAG_POSTTRANS, "POSTTRANS", RPM_STRING_TYPE},
+	{RPMTAG_PRETRANSPROG, "PRETRANSPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_POSTTRANSPROG, "POSTTRANSPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_DISTTAG, "DISTTAG", RPM_STRING_TYPE},
+	{RPMTAG_OLDSUGGESTSNAME, "OLDSUGGESTSNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_OLDSUGGESTSVERSION, "OLDSUGGESTSVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_OLDSUGGESTSFLAGS, "OLDSUGGESTSFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_OLDENHANCESNAME, "OLDENHANCESNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_OLDENHANCESVERSION, "OLDENHANCESVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_OLDENHANCESFLAGS, "OLDENHANCESFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_PRIORITY, "PRIORITY", RPM_INT32_TYPE},
+	{RPMTAG_RPMLIBVERSION, "RPMLIBVERSION", RPM_INT32_TYPE},
+	{RPMTAG_RPMLIBTIMESTAMP, "RPMLIBTIMESTAMP", RPM_INT32_TYPE},
+	{RPMTAG_RPMLIBVENDOR, "RPMLIBVENDOR", RPM_INT32_TYPE},
+	{RPMTAG_CVSID, "CVSID", RPM_STRING_TYPE},
+	{RPMTAG_BLINKPKGID, "BLINKPKGID", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_BLINKHDRID, "BLINKHDRID", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_BLINKNEVRA, "BLINKNEVRA", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FLINKPKGID, "FLINKPKGID", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FLINKHDRID, "FLINKHDRID", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FLINKNEVRA, "FLINKNEVRA", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PACKAGEORIGIN, "PACKAGEORIGIN", RPM_STRING_TYPE},
+	{RPMTAG_TRIGGERPREIN, "TRIGGERPREIN", RPM_NULL_TYPE},
+	{RPMTAG_BUILDSUGGESTS, "BUILDSUGGESTS", RPM_NULL_TYPE},
+	{RPMTAG_BUILDENHANCES, "BUILDENHANCES", RPM_NULL_TYPE},
+	{RPMTAG_SCRIPTSTATES, "SCRIPTSTATES", RPM_INT32_TYPE},
+	{RPMTAG_SCRIPTMETRICS, "SCRIPTMETRICS", RPM_INT32_TYPE},
+	{RPMTAG_BUILDCPUCLOCK, "BUILDCPUCLOCK", RPM_INT32_TYPE},
+	{RPMTAG_FILEDIGESTALGOS, "FILEDIGESTALGOS", RPM_INT32_TYPE},
+	{RPMTAG_VARIANTS, "VARIANTS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_XMAJOR, "XMAJOR", RPM_INT32_TYPE},
+	{RPMTAG_XMINOR, "XMINOR", RPM_INT32_TYPE},
+	{RPMTAG_REPOTAG, "REPOTAG", RPM_STRING_TYPE},
+	{RPMTAG_KEYWORDS, "KEYWORDS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_BUILDPLATFORMS, "BUILDPLATFORMS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PACKAGECOLOR, "PACKAGECOLOR", RPM_INT32_TYPE},
+	{RPMTAG_PACKAGEPREFCOLOR, "PACKAGEPREFCOLOR", RPM_INT32_TYPE},
+	{RPMTAG_XATTRSDICT, "XATTRSDICT", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILEXATTRSX, "FILEXATTRSX", RPM_INT32_TYPE},
+	{RPMTAG_DEPATTRSDICT, "DEPATTRSDICT", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_CONFLICTATTRSX, "CONFLICTATTRSX", RPM_INT32_TYPE},
+	{RPMTAG_OBSOLETEATTRSX, "OBSOLETEATTRSX", RPM_INT32_TYPE},
+	{RPMTAG_PROVIDEATTRSX, "PROVIDEATTRSX", RPM_INT32_TYPE},
+	{RPMTAG_REQUIREATTRSX, "REQUIREATTRSX", RPM_INT32_TYPE},
+	{RPMTAG_BUILDPROVIDES, "BUILDPROVIDES", RPM_NULL_TYPE},
+	{RPMTAG_BUILDOBSOLETES, "BUILDOBSOLETES", RPM_NULL_TYPE},
+	{RPMTAG_DBINSTANCE, "DBINSTANCE", RPM_INT32_TYPE},
+	{RPMTAG_NVRA, "NVRA", RPM_STRING_TYPE},
+	{RPMTAG_FILENAMES, "FILENAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILEPROVIDE, "FILEPROVIDE", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILEREQUIRE, "FILEREQUIRE", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FSNAMES, "FSNAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FSSIZES, "FSSIZES", RPM_INT64_TYPE},
+	{RPMTAG_TRIGGERCONDS, "TRIGGERCONDS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRIGGERTYPE, "TRIGGERTYPE", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ORIGFILENAMES, "ORIGFILENAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_LONGFILESIZES, "LONGFILESIZES", RPM_INT64_TYPE},
+	{RPMTAG_LONGSIZE, "LONGSIZE", RPM_INT64_TYPE},
+	{RPMTAG_FILECAPS, "FILECAPS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILEDIGESTALGO, "FILEDIGESTALGO", RPM_INT32_TYPE},
+	{RPMTAG_BUGURL, "BUGURL", RPM_STRING_TYPE},
+	{RPMTAG_EVR, "EVR", RPM_STRING_TYPE},
+	{RPMTAG_NVR, "NVR", RPM_STRING_TYPE},
+	{RPMTAG_NEVR, "NEVR", RPM_STRING_TYPE},
+	{RPMTAG_NEVRA, "NEVRA", RPM_STRING_TYPE},
+	{RPMTAG_HEADERCOLOR, "HEADERCOLOR", RPM_INT32_TYPE},
+	{RPMTAG_VERBOSE, "VERBOSE", RPM_INT32_TYPE},
+	{RPMTAG_EPOCHNUM, "EPOCHNUM", RPM_INT32_TYPE},
+	{RPMTAG_PREINFLAGS, "PREINFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_POSTINFLAGS, "POSTINFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_PREUNFLAGS, "PREUNFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_POSTUNFLAGS, "POSTUNFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_PRETRANSFLAGS, "PRETRANSFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_POSTTRANSFLAGS, "POSTTRANSFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_VERIFYSCRIPTFLAGS, "VERIFYSCRIPTFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_TRIGGERSCRIPTFLAGS, "TRIGGERSCRIPTFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_COLLECTIONS, "COLLECTIONS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_POLICYNAMES, "POLICYNAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_POLICYTYPES, "POLICYTYPES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_POLICYTYPESINDEXES, "POLICYTYPESINDEXES", RPM_INT32_TYPE},
+	{RPMTAG_POLICYFLAGS, "POLICYFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_VCS, "VCS", RPM_STRING_TYPE},
+	{RPMTAG_ORDERNAME, "ORDERNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ORDERVERSION, "ORDERVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ORDERFLAGS, "ORDERFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_MSSFMANIFEST, "MSSFMANIFEST", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_MSSFDOMAIN, "MSSFDOMAIN", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_INSTFILENAMES, "INSTFILENAMES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_REQUIRENEVRS, "REQUIRENEVRS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PROVIDENEVRS, "PROVIDENEVRS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_OBSOLETENEVRS, "OBSOLETENEVRS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_CONFLICTNEVRS, "CONFLICTNEVRS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILENLINKS, "FILENLINKS", RPM_INT32_TYPE},
+	{RPMTAG_RECOMMENDNAME, "RECOMMENDNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_RECOMMENDVERSION, "RECOMMENDVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_RECOMMENDFLAGS, "RECOMMENDFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_SUGGESTNAME, "SUGGESTNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_SUGGESTVERSION, "SUGGESTVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_SUGGESTFLAGS, "SUGGESTFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_SUPPLEMENTNAME, "SUPPLEMENTNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_SUPPLEMENTVERSION, "SUPPLEMENTVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_SUPPLEMENTFLAGS, "SUPPLEMENTFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_ENHANCENAME, "ENHANCENAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ENHANCEVERSION, "ENHANCEVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ENHANCEFLAGS, "ENHANCEFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_RECOMMENDNEVRS, "RECOMMENDNEVRS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_SUGGESTNEVRS, "SUGGESTNEVRS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_SUPPLEMENTNEVRS, "SUPPLEMENTNEVRS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ENHANCENEVRS, "ENHANCENEVRS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ENCODING, "ENCODING", RPM_STRING_TYPE},
+	{RPMTAG_FILETRIGGERIN, "FILETRIGGERIN", RPM_NULL_TYPE},
+	{RPMTAG_FILETRIGGERUN, "FILETRIGGERUN", RPM_NULL_TYPE},
+	{RPMTAG_FILETRIGGERPOSTUN, "FILETRIGGERPOSTUN", RPM_NULL_TYPE},
+	{RPMTAG_FILETRIGGERSCRIPTS, "FILETRIGGERSCRIPTS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILETRIGGERSCRIPTPROG, "FILETRIGGERSCRIPTPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILETRIGGERSCRIPTFLAGS, "FILETRIGGERSCRIPTFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_FILETRIGGERNAME, "FILETRIGGERNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILETRIGGERINDEX, "FILETRIGGERINDEX", RPM_INT32_TYPE},
+	{RPMTAG_FILETRIGGERVERSION, "FILETRIGGERVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILETRIGGERFLAGS, "FILETRIGGERFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_TRANSFILETRIGGERIN, "TRANSFILETRIGGERIN", RPM_NULL_TYPE},
+	{RPMTAG_TRANSFILETRIGGERUN, "TRANSFILETRIGGERUN", RPM_NULL_TYPE},
+	{RPMTAG_TRANSFILETRIGGERPOSTUN, "TRANSFILETRIGGERPOSTUN", RPM_NULL_TYPE},
+	{RPMTAG_TRANSFILETRIGGERSCRIPTS, "TRANSFILETRIGGERSCRIPTS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRANSFILETRIGGERSCRIPTPROG, "TRANSFILETRIGGERSCRIPTPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRANSFILETRIGGERSCRIPTFLAGS, "TRANSFILETRIGGERSCRIPTFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_TRANSFILETRIGGERNAME, "TRANSFILETRIGGERNAME", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRANSFILETRIGGERINDEX, "TRANSFILETRIGGERINDEX", RPM_INT32_TYPE},
+	{RPMTAG_TRANSFILETRIGGERVERSION, "TRANSFILETRIGGERVERSION", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRANSFILETRIGGERFLAGS, "TRANSFILETRIGGERFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_REMOVEPATHPOSTFIXES, "REMOVEPATHPOSTFIXES", RPM_STRING_TYPE},
+	{RPMTAG_FILETRIGGERPRIORITIES, "FILETRIGGERPRIORITIES", RPM_INT32_TYPE},
+	{RPMTAG_TRANSFILETRIGGERPRIORITIES, "TRANSFILETRIGGERPRIORITIES", RPM_INT32_TYPE},
+	{RPMTAG_FILETRIGGERCONDS, "FILETRIGGERCONDS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILETRIGGERTYPE, "FILETRIGGERTYPE", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRANSFILETRIGGERCONDS, "TRANSFILETRIGGERCONDS", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_TRANSFILETRIGGERTYPE, "TRANSFILETRIGGERTYPE", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILESIGNATURES, "FILESIGNATURES", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_FILESIGNATURELENGTH, "FILESIGNATURELENGTH", RPM_INT32_TYPE},
+	{RPMTAG_PAYLOADDIGEST, "PAYLOADDIGEST", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PAYLOADDIGESTALGO, "PAYLOADDIGESTALGO", RPM_INT32_TYPE},
+	{RPMTAG_AUTOINSTALLED, "AUTOINSTALLED", RPM_INT32_TYPE},
+	{RPMTAG_IDENTITY, "IDENTITY", RPM_STRING_TYPE},
+	{RPMTAG_MODULARITYLABEL, "MODULARITYLABEL", RPM_STRING_TYPE},
+	{RPMTAG_PAYLOADDIGESTALT, "PAYLOADDIGESTALT", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_ARCHSUFFIX, "ARCHSUFFIX", RPM_STRING_TYPE},
+	{RPMTAG_SPEC, "SPEC", RPM_STRING_TYPE},
+	{RPMTAG_TRANSLATIONURL, "TRANSLATIONURL", RPM_STRING_TYPE},
+	{RPMTAG_UPSTREAMRELEASES, "UPSTREAMRELEASES", RPM_STRING_TYPE},
+	{RPMTAG_SOURCELICENSE, "SOURCELICENSE", RPM_NULL_TYPE},
+	{RPMTAG_PREUNTRANS, "PREUNTRANS", RPM_STRING_TYPE},
+	{RPMTAG_POSTUNTRANS, "POSTUNTRANS", RPM_STRING_TYPE},
+	{RPMTAG_PREUNTRANSPROG, "PREUNTRANSPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_POSTUNTRANSPROG, "POSTUNTRANSPROG", RPM_STRING_ARRAY_TYPE},
+	{RPMTAG_PREUNTRANSFLAGS, "PREUNTRANSFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_POSTUNTRANSFLAGS, "POSTUNTRANSFLAGS", RPM_INT32_TYPE},
+	{RPMTAG_SYSUSERS, "SYSUSERS", RPM_STRING_ARRAY_TYPE},
+}
+
+var tagAliases = map[string]int32{
+	"PGP":         RPMTAG_PGP,
+	"PKGID":       RPMTAG_PKGID,
+	"HDRID":       RPMTAG_HDRID,
+	"N":           RPMTAG_N,
+	"FILEMD5S":    RPMTAG_FILEMD5S,
+	"PROVIDES":    RPMTAG_PROVIDES,
+	"P":           RPMTAG_P,
+	"REQUIRES":    RPMTAG_REQUIRES,
+	"CONFLICTS":   RPMTAG_CONFLICTS,
+	"C":           RPMTAG_C,
+	"OBSOLETES":   RPMTAG_OBSOLETES,
+	"O":           RPMTAG_O,
+	"SVNID":       RPMTAG_SVNID,
+	"OLDSUGGESTS": RPMTAG_OLDSUGGESTS,
+	"OLDENHANCES": RPMTAG_OLDENHANCES,
+	"RECOMMENDS":  RPMTAG_RECOMMENDS,
+	"SUGGESTS":    RPMTAG_SUGGESTS,
+	"SUPPLEMENTS": RPMTAG_SUPPLEMENTS,
+	"ENHANCES":    RPMTAG_ENHANCES,
+}
+
+var tagsByValue, tagsByName = func() (map[int32]tagInfo, map[string]int32) {
+	byValue := make(map[int32]tagInfo, len(tagTable))
+	byName := make(map[string]int32, len(tagTable)+len(tagAliases))
+	for _, ti := range tagTable {
+		byValue[ti.tag] = ti
+		byName[ti.name] = ti.tag
+	}
+	for name, tag := range tagAliases {
+		byName[name] = tag
+	}
+	return byValue, byName
+}()
+
+// TagName returns the name of tag as printed by rpm --querytags, e.g. "NAME".
+// Unknown tags are named by their number, e.g. "Tag_12345".
+func TagName(tag int32) string {
+	if ti, ok := tagsByValue[tag]; ok {
+		return ti.name
+	}
+	return fmt.Sprintf("Tag_%d", tag)
+}
+
+// TagByName returns the tag with the given name. Like rpm, the lookup is case
+// insensitive and accepts an optional "RPMTAG_" prefix, so "name", "NAME" and
+// "RPMTAG_NAME" all return RPMTAG_NAME.
+func TagByName(name string) (int32, bool) {
+	name = strings.ToUpper(name)
+	tag, ok := tagsByName[strings.TrimPrefix(name, "RPMTAG_")]
+	return tag, ok
+}
+
+// TagType returns the type (RPM_*_TYPE) a tag is expected to be stored with.
+func TagType(tag int32) (uint32, bool) {
+	ti, ok := tagsByValue[tag]
+	if !ok {
+		return RPM_NULL_TYPE, false
+	}
+	return ti.typ, true
+}
+
+var typeNames = []string{"NULL", "CHAR", "INT8", "INT16", "INT32", "INT64", "STRING", "BIN", "STRING_ARRAY", "I18NSTRING"}
+
+// typeName returns the name of a RPM_*_TYPE value, e.g. "STRING".
+func typeName(typ uint32) string {
+	if int(typ) < len(typeNames) {
+		return typeNames[typ]
+	}
+	return fmt.Sprintf("Type_%d", typ)
+}
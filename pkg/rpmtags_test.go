@@ -0,0 +1,84 @@
+package rpmdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagName(t *testing.T) {
+	tests := []struct {
+		tag  int32
+		want string
+	}{
+		{tag: RPMTAG_NAME, want: "NAME"},
+		{tag: RPMTAG_SIGMD5, want: "SIGMD5"},
+		{tag: RPMTAG_HEADERI18NTABLE, want: "HEADERI18NTABLE"},
+		{tag: RPMTAG_MODULARITYLABEL, want: "MODULARITYLABEL"},
+		{tag: 12345, want: "Tag_12345"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, TagName(tt.tag))
+		})
+	}
+}
+
+func TestTagByName(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   int32
+		wantOk bool
+	}{
+		{name: "NAME", want: RPMTAG_NAME, wantOk: true},
+		{name: "buildtime", want: RPMTAG_BUILDTIME, wantOk: true},
+		{name: "RPMTAG_URL", want: RPMTAG_URL, wantOk: true},
+		{name: "requires", want: RPMTAG_REQUIRENAME, wantOk: true},
+		{name: "PKGID", want: RPMTAG_SIGMD5, wantOk: true},
+		{name: "NOSUCHTAG", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := TagByName(tt.name)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagType(t *testing.T) {
+	tests := []struct {
+		tag    int32
+		want   uint32
+		wantOk bool
+	}{
+		{tag: RPMTAG_NAME, want: RPM_STRING_TYPE, wantOk: true},
+		{tag: RPMTAG_SUMMARY, want: RPM_I18NSTRING_TYPE, wantOk: true},
+		{tag: RPMTAG_REQUIRENAME, want: RPM_STRING_ARRAY_TYPE, wantOk: true},
+		{tag: RPMTAG_FILEMODES, want: RPM_INT16_TYPE, wantOk: true},
+		{tag: RPMTAG_LONGSIZE, want: RPM_INT64_TYPE, wantOk: true},
+		{tag: RPMTAG_RSAHEADER, want: RPM_BIN_TYPE, wantOk: true},
+		{tag: 12345, want: RPM_NULL_TYPE, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(TagName(tt.tag), func(t *testing.T) {
+			got, ok := TagType(tt.tag)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_tagTable(t *testing.T) {
+	names := make(map[string]struct{})
+	values := make(map[int32]struct{})
+	for _, ti := range tagTable {
+		assert.NotContains(t, names, ti.name)
+		assert.NotContains(t, values, ti.tag)
+		names[ti.name] = struct{}{}
+		values[ti.tag] = struct{}{}
+	}
+	for name := range tagAliases {
+		assert.NotContains(t, names, name)
+	}
+}
@@ -0,0 +1,190 @@
+package rpmdb
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// SignatureStatus is the outcome of checking the OpenPGP signature of a header.
+type SignatureStatus int
+
+const (
+	SignatureUnsigned   SignatureStatus = iota // no header signature
+	SignatureGood                              // signed by a key of the keyring
+	SignatureBad                               // the signature does not match the header
+	SignatureUnknownKey                        // signed by a key missing from the keyring
+)
+
+func (s SignatureStatus) String() string {
+	switch s {
+	case SignatureUnsigned:
+		return "unsigned"
+	case SignatureGood:
+		return "OK"
+	case SignatureBad:
+		return "BAD"
+	case SignatureUnknownKey:
+		return "NOKEY"
+	default:
+		return "unknown"
+	}
+}
+
+// SignatureVerdict is the result of checking the signature of a package, like
+// the Header V4 RSA/SHA256 Signature line of rpm -Kv.
+type SignatureVerdict struct {
+	Package *PackageInfo
+	Status  SignatureStatus
+	Tag     int32  // RPMTAG_OPENPGP, RPMTAG_RSAHEADER or RPMTAG_DSAHEADER, 0 if unsigned
+	KeyID   string // 16 hex digits, empty if unsigned
+	Err     error  // why the signature is bad
+}
+
+// gpgPubkeyName is the name of the pseudo packages rpm --import stores keys as.
+const gpgPubkeyName = "gpg-pubkey"
+
+// Keyring returns the keys imported into the rpmdb with rpm --import, which rpm
+// itself trusts. More keys can be read into it before verifying signatures.
+func (d *RpmDB) Keyring() (*Keyring, error) {
+	return d.KeyringContext(context.Background())
+}
+
+// KeyringContext is like Keyring, but gives up once ctx is done.
+func (d *RpmDB) KeyringContext(ctx context.Context) (*Keyring, error) {
+	keyring := NewKeyring()
+	for h, err := range d.HeadersContext(ctx) {
+		if err != nil {
+			return nil, xerrors.Errorf("unable to read headers: %w", err)
+		}
+		if name, err := h.GetString(RPMTAG_NAME); err != nil || name != gpgPubkeyName {
+			continue
+		}
+
+		// RPMTAG_PUBKEYS holds the key without armor, the description the armored key
+		if pubkeys, err := h.GetStringArray(RPMTAG_PUBKEYS); err == nil && len(pubkeys) > 0 {
+			for _, pubkey := range pubkeys {
+				data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(pubkey), ""))
+				if err != nil {
+					return nil, xerrors.Errorf("invalid %s key: %w", gpgPubkeyName, err)
+				}
+				if err := keyring.add(data); err != nil {
+					return nil, xerrors.Errorf("invalid %s key: %w", gpgPubkeyName, err)
+				}
+			}
+			continue
+		}
+		description, err := h.GetString(RPMTAG_DESCRIPTION)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid %s key: %w", gpgPubkeyName, err)
+		}
+		if err := keyring.ReadArmored(strings.NewReader(description)); err != nil {
+			return nil, xerrors.Errorf("invalid %s key: %w", gpgPubkeyName, err)
+		}
+	}
+	return keyring, nil
+}
+
+// VerifySignatures checks the OpenPGP signature over the immutable region of
+// every package header against keyring, the way rpm does when reading the rpmdb
+// with signature checking enabled. The gpg-pubkey packages are not checked.
+func (d *RpmDB) VerifySignatures(keyring *Keyring) ([]SignatureVerdict, error) {
+	return d.VerifySignaturesContext(context.Background(), keyring)
+}
+
+// VerifySignaturesContext is like VerifySignatures, but gives up once ctx is done.
+func (d *RpmDB) VerifySignaturesContext(ctx context.Context, keyring *Keyring) ([]SignatureVerdict, error) {
+	var verdicts []SignatureVerdict
+	for h, err := range d.HeadersContext(ctx) {
+		if err != nil {
+			return nil, xerrors.Errorf("unable to read headers: %w", err)
+		}
+		pkg, err := h.PackageInfo()
+		if err != nil {
+			return nil, err
+		}
+		if pkg.Name == gpgPubkeyName {
+			continue
+		}
+
+		verdict := h.verifySignature(keyring)
+		verdict.Package = pkg
+		verdicts = append(verdicts, verdict)
+	}
+	return verdicts, nil
+}
+
+// verifySignature checks every header signature. RPMTAG_OPENPGP replaces the other
+// tags in rpm 6 and may hold several signatures. The verdict is bad if any
+// signature is bad, and good if any other one is good.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/rpmvs.c
+func (h *Header) verifySignature(keyring *Keyring) SignatureVerdict {
+	var signatures [][]byte
+	var tag int32
+	if pgps, err := h.GetStringArray(RPMTAG_OPENPGP); err == nil && len(pgps) > 0 {
+		tag = RPMTAG_OPENPGP
+		for _, s := range pgps {
+			sig, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return SignatureVerdict{Status: SignatureBad, Tag: tag, Err: xerrors.Errorf("invalid signature encoding: %w", err)}
+			}
+			signatures = append(signatures, sig)
+		}
+	} else {
+		for _, t := range []int32{RPMTAG_RSAHEADER, RPMTAG_DSAHEADER} {
+			if sig, err := h.GetBinary(t); err == nil && len(sig) > 0 {
+				tag = t
+				signatures = append(signatures, sig)
+				break
+			}
+		}
+	}
+	if len(signatures) == 0 {
+		return SignatureVerdict{Status: SignatureUnsigned}
+	}
+
+	region, err := h.immutableRegion()
+	if err == nil && region == nil {
+		err = xerrors.New("no immutable region")
+	}
+	if err != nil {
+		return SignatureVerdict{Status: SignatureBad, Tag: tag, Err: err}
+	}
+
+	verdict := SignatureVerdict{Status: SignatureUnknownKey, Tag: tag}
+	for _, data := range signatures {
+		sig, err := parsePGPSignature(data)
+		if err != nil {
+			return SignatureVerdict{Status: SignatureBad, Tag: tag, Err: err}
+		}
+		keyID := formatKeyID(sig.keyID)
+
+		var keys []*pgpPublicKey
+		if keyring != nil {
+			keys = keyring.keys[sig.keyID]
+		}
+		if len(keys) == 0 {
+			if verdict.KeyID == "" {
+				verdict.KeyID = keyID
+			}
+			continue
+		}
+
+		// a key ID may match several keys, one of them has to verify the signature
+		var verifyErr error
+		for _, key := range keys {
+			if verifyErr = sig.verify(key, region); verifyErr == nil {
+				break
+			}
+		}
+		if verifyErr != nil {
+			return SignatureVerdict{Status: SignatureBad, Tag: tag, KeyID: keyID, Err: verifyErr}
+		}
+		if verdict.Status != SignatureGood {
+			verdict.Status, verdict.KeyID = SignatureGood, keyID
+		}
+	}
+	return verdict
+}
@@ -0,0 +1,598 @@
+package rpmdb
+
+import (
+	"bytes"
+	"crypto"
+	_ "crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRpmDB_VerifySignatures(t *testing.T) {
+	tests := []struct {
+		name string
+		file string // Test input file
+		want SignatureVerdict
+	}{
+		{
+			name: "BerkeleyDB with a v3 signature",
+			file: "testdata/libuuid/Packages",
+			want: SignatureVerdict{Status: SignatureUnknownKey, Tag: RPMTAG_RSAHEADER, KeyID: "199e2f91fd431d51"},
+		},
+		{
+			name: "NDB with a v3 signature",
+			file: "testdata/sle15-bci/Packages.db",
+			want: SignatureVerdict{Status: SignatureUnknownKey, Tag: RPMTAG_RSAHEADER, KeyID: "70af9e8139db7c82"},
+		},
+		{
+			name: "SQLite3 with a v4 signature",
+			file: "testdata/cbl-mariner-2.0/rpmdb.sqlite",
+			want: SignatureVerdict{Status: SignatureUnknownKey, Tag: RPMTAG_RSAHEADER, KeyID: "0cd9fed33135ce90"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := Open(tt.file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			keyring, err := db.Keyring()
+			require.NoError(t, err)
+			assert.Empty(t, keyring.KeyIDs())
+
+			verdicts, err := db.VerifySignatures(keyring)
+			require.NoError(t, err)
+			require.NotEmpty(t, verdicts)
+			for _, got := range verdicts {
+				assert.NotNil(t, got.Package)
+				got.Package = nil
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRpmDB_VerifySignatures_keyring(t *testing.T) {
+	// the signatures were made by gpg --detach-sign over the immutable region of bash
+	tests := []struct {
+		name string
+		key  string
+		tag  int32
+	}{
+		{name: "RSA", key: "rsa", tag: RPMTAG_RSAHEADER},
+		{name: "DSA", key: "dsa", tag: RPMTAG_DSAHEADER},
+		{name: "ECDSA", key: "ecdsa", tag: RPMTAG_DSAHEADER},
+		{name: "EdDSA", key: "ed25519", tag: RPMTAG_OPENPGP},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, err := os.ReadFile(filepath.Join("testdata/pgp", tt.key+".sig"))
+			require.NoError(t, err)
+
+			keyring := NewKeyring()
+			f, err := os.Open(filepath.Join("testdata/pgp", tt.key+".asc"))
+			require.NoError(t, err)
+			defer f.Close()
+			require.NoError(t, keyring.ReadArmored(f))
+			require.Len(t, keyring.KeyIDs(), 1)
+			keyID := keyring.KeyIDs()[0]
+
+			tag, typ, data := tt.tag, uint32(RPM_BIN_TYPE), sig
+			if tag == RPMTAG_OPENPGP {
+				typ, data = RPM_STRING_ARRAY_TYPE, append([]byte(base64.StdEncoding.EncodeToString(sig)), 0)
+			}
+
+			file := resignBash(t, func(blob []byte) []byte { return signHeader(t, blob, headerTag{tag, typ, data}) })
+			verdict := bashVerdict(t, file, keyring)
+			assert.Equal(t, SignatureGood, verdict.Status)
+			assert.Equal(t, tt.tag, verdict.Tag)
+			assert.Equal(t, keyID, verdict.KeyID)
+			assert.NoError(t, verdict.Err)
+
+			// change the summary, which is in the immutable region
+			file = resignBash(t, func(blob []byte) []byte {
+				blob = bytes.Replace(blob, []byte("Bourne"), []byte("Bourny"), 1)
+				return signHeader(t, blob, headerTag{tag, typ, data})
+			})
+			verdict = bashVerdict(t, file, keyring)
+			assert.Equal(t, SignatureBad, verdict.Status)
+			assert.Equal(t, keyID, verdict.KeyID)
+			assert.Error(t, verdict.Err)
+		})
+	}
+}
+
+func TestRpmDB_VerifySignatures_unsigned(t *testing.T) {
+	file := resignBash(t, func(blob []byte) []byte {
+		return signHeader(t, blob)
+	})
+	verdict := bashVerdict(t, file, NewKeyring())
+	assert.Equal(t, SignatureVerdict{Package: verdict.Package, Status: SignatureUnsigned}, verdict)
+}
+
+func TestRpmDB_Keyring(t *testing.T) {
+	armored, err := os.ReadFile("testdata/pgp/rsa.asc")
+	require.NoError(t, err)
+	blocks, err := decodeArmor(bytes.NewReader(armored))
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+
+	sig, err := os.ReadFile("testdata/pgp/rsa.sig")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		tag  int32
+		typ  uint32
+		data []byte
+	}{
+		{
+			name: "PUBKEYS",
+			tag:  RPMTAG_PUBKEYS,
+			typ:  RPM_STRING_ARRAY_TYPE,
+			data: append([]byte(base64.StdEncoding.EncodeToString(blocks[0])), 0),
+		},
+		{
+			name: "armored description",
+			tag:  RPMTAG_DESCRIPTION,
+			typ:  RPM_STRING_TYPE,
+			data: append(armored, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := resignBash(t, func(blob []byte) []byte { return signHeader(t, blob, headerTag{RPMTAG_RSAHEADER, RPM_BIN_TYPE, sig}) })
+
+			// import the key like rpm --import does
+			sqldb, err := sql.Open("sqlite", file)
+			require.NoError(t, err)
+			_, err = sqldb.Exec("INSERT INTO Packages (blob) VALUES (?)", legacyHeader(
+				headerTag{RPMTAG_NAME, RPM_STRING_TYPE, []byte("gpg-pubkey\x00")},
+				headerTag{RPMTAG_VERSION, RPM_STRING_TYPE, []byte("eda57fa4\x00")},
+				headerTag{RPMTAG_RELEASE, RPM_STRING_TYPE, []byte("6a3d7a87\x00")},
+				headerTag{tt.tag, tt.typ, tt.data},
+			))
+			require.NoError(t, err)
+			require.NoError(t, sqldb.Close())
+
+			db, err := Open(file)
+			require.NoError(t, err)
+			defer db.Close()
+
+			keyring, err := db.Keyring()
+			require.NoError(t, err)
+			assert.Equal(t, []string{"7962cbd1eda57fa4"}, keyring.KeyIDs())
+
+			verdicts, err := db.VerifySignatures(keyring)
+			require.NoError(t, err)
+			assert.Len(t, verdicts, 129) // gpg-pubkey is left out
+			for _, verdict := range verdicts {
+				if verdict.Package.Name == "bash" {
+					assert.Equal(t, SignatureGood, verdict.Status)
+				} else {
+					assert.Equal(t, SignatureUnknownKey, verdict.Status)
+				}
+			}
+		})
+	}
+}
+
+func Test_decodeArmor(t *testing.T) {
+	armored, err := os.ReadFile("testdata/pgp/ecdsa.asc")
+	require.NoError(t, err)
+
+	blocks, err := decodeArmor(bytes.NewReader(append(armored, armored...)))
+	require.NoError(t, err)
+	assert.Len(t, blocks, 2)
+
+	// corrupt the last byte of the first line of base64
+	i := bytes.Index(armored, []byte("\n\n")) + 2
+	i += bytes.IndexByte(armored[i:], '\n') - 1
+	corrupted := append([]byte{}, armored...)
+	corrupted[i] ^= 1
+	_, err = decodeArmor(bytes.NewReader(corrupted))
+	assert.ErrorContains(t, err, "armor checksum mismatch")
+}
+
+func bashVerdict(t *testing.T, file string, keyring *Keyring) SignatureVerdict {
+	t.Helper()
+	db, err := Open(file)
+	require.NoError(t, err)
+	defer db.Close()
+
+	verdicts, err := db.VerifySignatures(keyring)
+	require.NoError(t, err)
+	for _, verdict := range verdicts {
+		if verdict.Package.Name == "bash" {
+			return verdict
+		}
+	}
+	t.Fatal("bash not found")
+	return SignatureVerdict{}
+}
+
+// resignBash returns a copy of the CBL-Mariner database with the header of bash
+// rewritten by f.
+func resignBash(t *testing.T, f func([]byte) []byte) string {
+	t.Helper()
+	file := copyFile(t, "testdata/cbl-mariner-2.0/rpmdb.sqlite", filepath.Join(t.TempDir(), "rpmdb.sqlite"))
+
+	sqldb, err := sql.Open("sqlite", file)
+	require.NoError(t, err)
+	defer sqldb.Close()
+
+	var blob []byte
+	require.NoError(t, sqldb.QueryRow("SELECT blob FROM Packages WHERE hnum = 29").Scan(&blob))
+	_, err = sqldb.Exec("UPDATE Packages SET blob = ? WHERE hnum = 29", f(blob))
+	require.NoError(t, err)
+	return file
+}
+
+type headerTag struct {
+	tag  int32
+	typ  uint32
+	data []byte
+}
+
+// signHeader keeps the immutable region of the header blob and replaces the tags
+// added at installation with its digests and the given tags, such as a signature.
+// The tags must not need alignment.
+func signHeader(t *testing.T, blob []byte, tags ...headerTag) []byte {
+	t.Helper()
+	h, err := parseHeader(blob)
+	require.NoError(t, err)
+	region, err := h.immutableRegion()
+	require.NoError(t, err)
+	sha1sum, sha256sum := sha1.Sum(region), sha256.Sum256(region)
+	tags = append([]headerTag{
+		{RPMTAG_SHA1HEADER, RPM_STRING_TYPE, []byte(hex.EncodeToString(sha1sum[:]) + "\x00")},
+		{RPMTAG_SHA256HEADER, RPM_STRING_TYPE, []byte(hex.EncodeToString(sha256sum[:]) + "\x00")},
+	}, tags...)
+
+	hb, err := hdrblobInit(blob)
+	require.NoError(t, err)
+	entries := append([]byte{}, blob[8:8+hb.ril*REGION_TAG_COUNT]...)
+	data := append([]byte{}, blob[hb.dataStart:hb.dataStart+hb.rdl]...)
+	for _, tag := range tags {
+		entries = appendEntry(entries, tag, int32(len(data)))
+		data = append(data, tag.data...)
+	}
+
+	signed := binary.BigEndian.AppendUint32(nil, uint32(hb.ril)+uint32(len(tags)))
+	signed = binary.BigEndian.AppendUint32(signed, uint32(len(data)))
+	signed = append(signed, entries...)
+	return append(signed, data...)
+}
+
+// legacyHeader builds a header without a region, as rpm used to write. The tags
+// must not need alignment.
+func legacyHeader(tags ...headerTag) []byte {
+	var entries, data []byte
+	for _, tag := range tags {
+		entries = appendEntry(entries, tag, int32(len(data)))
+		data = append(data, tag.data...)
+	}
+	h := binary.BigEndian.AppendUint32(nil, uint32(len(tags)))
+	h = binary.BigEndian.AppendUint32(h, uint32(len(data)))
+	h = append(h, entries...)
+	return append(h, data...)
+}
+
+func appendEntry(entries []byte, tag headerTag, offset int32) []byte {
+	count := uint32(len(tag.data))
+	switch tag.typ {
+	case RPM_STRING_TYPE:
+		count = 1
+	case RPM_STRING_ARRAY_TYPE:
+		count = uint32(bytes.Count(tag.data, []byte{0}))
+	case RPM_INT32_TYPE:
+		count /= 4
+	case RPM_INT64_TYPE:
+		count /= 8
+	}
+	entries = binary.BigEndian.AppendUint32(entries, uint32(tag.tag))
+	entries = binary.BigEndian.AppendUint32(entries, tag.typ)
+	entries = binary.BigEndian.AppendUint32(entries, uint32(offset))
+	return binary.BigEndian.AppendUint32(entries, count)
+}
+
+func Test_pgpSignature_verify(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key := &pgpPublicKey{keyID: 0x0123456789abcdef, algo: pgpPubKeyRSA, public: &priv.PublicKey}
+	data := []byte("immutable region")
+
+	tests := []struct {
+		name     string
+		sigType  uint8
+		pubAlgo  uint8
+		hashAlgo uint8
+		hash     crypto.Hash
+		wantErr  string
+	}{
+		{name: "SHA256", sigType: pgpSigBinary, pubAlgo: pgpPubKeyRSA, hashAlgo: PGPHASHALGO_SHA256, hash: crypto.SHA256},
+		{name: "sign-only RSA", sigType: pgpSigBinary, pubAlgo: pgpPubKeyRSASign, hashAlgo: PGPHASHALGO_SHA256, hash: crypto.SHA256},
+		{
+			name:     "MD5",
+			sigType:  pgpSigBinary,
+			pubAlgo:  pgpPubKeyRSA,
+			hashAlgo: PGPHASHALGO_MD5,
+			hash:     crypto.MD5,
+			wantErr:  "unsupported hash algorithm",
+		},
+		{
+			name:     "text signature",
+			sigType:  0x01,
+			pubAlgo:  pgpPubKeyRSA,
+			hashAlgo: PGPHASHALGO_SHA256,
+			hash:     crypto.SHA256,
+			wantErr:  "unexpected signature type",
+		},
+		{
+			name:     "algorithm mismatch",
+			sigType:  pgpSigBinary,
+			pubAlgo:  pgpPubKeyDSA,
+			hashAlgo: PGPHASHALGO_SHA256,
+			hash:     crypto.SHA256,
+			wantErr:  "does not match key algorithm",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// a version 4 signature packet without hashed subpackets, signed
+			// correctly so that only the checks of the parameters can fail
+			hashed := []byte{4, tt.sigType, tt.pubAlgo, tt.hashAlgo, 0, 0}
+			h := tt.hash.New()
+			h.Write(data)
+			h.Write(hashed)
+			h.Write([]byte{4, 0xff})
+			h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(hashed))))
+			digest := h.Sum(nil)
+			s, err := rsa.SignPKCS1v15(nil, priv, tt.hash, digest)
+			require.NoError(t, err)
+
+			body := append([]byte{}, hashed...)
+			body = append(body, 0, 10, 9, pgpSubpacketIssuer)
+			body = binary.BigEndian.AppendUint64(body, key.keyID)
+			body = append(body, digest[:2]...)
+			body = binary.BigEndian.AppendUint16(body, uint16(len(s)*8))
+			body = append(body, s...)
+			// an old format packet with a two-octet length
+			packet := binary.BigEndian.AppendUint16([]byte{0x80 | pgpTagSignature<<2 | 1}, uint16(len(body)))
+			packet = append(packet, body...)
+
+			sig, err := parsePGPSignature(packet)
+			require.NoError(t, err)
+			assert.Equal(t, key.keyID, sig.keyID)
+
+			err = sig.verify(key, data)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestKeyring_selfSignatures(t *testing.T) {
+	const created = 1600000000
+	primary := newPGPTestKey(t, created)
+	sub := newPGPTestKey(t, created)
+
+	userID := []byte("go-rpmdb test <test@example.com>")
+	userIDData := binary.BigEndian.AppendUint32(append(primary.key.hashPrefix(), 0xb4), uint32(len(userID)))
+	userIDData = append(userIDData, userID...)
+	subkeyData := append(primary.key.hashPrefix(), sub.key.hashPrefix()...)
+
+	publicKey := pgpTestPacket(pgpTagPublicKey, primary.key.body)
+	publicSub := pgpTestPacket(pgpTagPublicSub, sub.key.body)
+	userIDPacket := pgpTestPacket(pgpTagUserID, userID)
+	cert := func(sigCreated uint32, subpackets ...[]byte) []byte {
+		return primary.sign(t, pgpSigPositiveCert, userIDData, append([][]byte{pgpCreationTime(sigCreated)}, subpackets...)...)
+	}
+	binding := func(subpackets ...[]byte) []byte {
+		return primary.sign(t, pgpSigSubkeyBinding, subkeyData, append([][]byte{pgpCreationTime(created)}, subpackets...)...)
+	}
+	revocation := primary.sign(t, pgpSigKeyRevocation, primary.key.hashPrefix(), pgpCreationTime(created+10))
+	forged := append([]byte{}, revocation...)
+	forged[len(forged)-1] ^= 1
+
+	keyExpiration := func(seconds uint32) []byte {
+		return binary.BigEndian.AppendUint32([]byte{pgpSubpacketKeyExpirationTime}, seconds)
+	}
+	signOnly := []byte{pgpSubpacketKeyFlags, pgpKeyFlagSign}
+	encryptOnly := []byte{pgpSubpacketKeyFlags, 0x0c}
+
+	tests := []struct {
+		name        string
+		packets     [][]byte
+		signer      pgpTestKey
+		signed      uint32 // creation time of the header signature
+		wantUnknown bool
+		wantErr     string
+	}{
+		{
+			name:    "certified",
+			packets: [][]byte{publicKey, userIDPacket, cert(created)},
+			signer:  primary,
+			signed:  created + 1000,
+		},
+		{
+			name:    "expired",
+			packets: [][]byte{publicKey, userIDPacket, cert(created, keyExpiration(100))},
+			signer:  primary,
+			signed:  created + 1000,
+			wantErr: "expired before the signature was made",
+		},
+		{
+			name:    "signed before the expiration",
+			packets: [][]byte{publicKey, userIDPacket, cert(created, keyExpiration(100))},
+			signer:  primary,
+			signed:  created + 50,
+		},
+		{
+			name:    "expiration removed by a newer self-signature",
+			packets: [][]byte{publicKey, userIDPacket, cert(created, keyExpiration(100)), cert(created + 10)},
+			signer:  primary,
+			signed:  created + 1000,
+		},
+		{
+			name:    "older self-signature",
+			packets: [][]byte{publicKey, userIDPacket, cert(created+10, keyExpiration(100)), cert(created)},
+			signer:  primary,
+			signed:  created + 1000,
+			wantErr: "expired before the signature was made",
+		},
+		{
+			name:    "revoked",
+			packets: [][]byte{publicKey, revocation, userIDPacket, cert(created)},
+			signer:  primary,
+			signed:  created + 1,
+			wantErr: "is revoked",
+		},
+		{
+			name:    "revocation with a bad signature",
+			packets: [][]byte{publicKey, forged, userIDPacket, cert(created)},
+			signer:  primary,
+			signed:  created + 1,
+		},
+		{
+			name:    "signing subkey",
+			packets: [][]byte{publicKey, userIDPacket, cert(created), publicSub, binding(signOnly)},
+			signer:  sub,
+			signed:  created + 1000,
+		},
+		{
+			name:        "subkey without binding signature",
+			packets:     [][]byte{publicKey, userIDPacket, cert(created), publicSub},
+			signer:      sub,
+			wantUnknown: true,
+		},
+		{
+			name:        "encryption subkey",
+			packets:     [][]byte{publicKey, userIDPacket, cert(created), publicSub, binding(encryptOnly)},
+			signer:      sub,
+			wantUnknown: true,
+		},
+		{
+			name: "revoked subkey",
+			packets: [][]byte{
+				publicKey, userIDPacket, cert(created), publicSub, binding(signOnly),
+				primary.sign(t, pgpSigSubkeyRevocation, subkeyData, pgpCreationTime(created+10)),
+			},
+			signer:  sub,
+			signed:  created + 1,
+			wantErr: "is revoked",
+		},
+		{
+			name:    "expired subkey",
+			packets: [][]byte{publicKey, userIDPacket, cert(created), publicSub, binding(signOnly, keyExpiration(100))},
+			signer:  sub,
+			signed:  created + 1000,
+			wantErr: "expired before the signature was made",
+		},
+		{
+			name:    "subkey of an expired key",
+			packets: [][]byte{publicKey, userIDPacket, cert(created, keyExpiration(100)), publicSub, binding(signOnly)},
+			signer:  sub,
+			signed:  created + 1000,
+			wantErr: "expired before the signature was made",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyring := NewKeyring()
+			require.NoError(t, keyring.Read(bytes.NewReader(bytes.Join(tt.packets, nil))))
+			keys := keyring.keys[tt.signer.key.keyID]
+			if tt.wantUnknown {
+				assert.Empty(t, keys)
+				return
+			}
+			require.Len(t, keys, 1)
+
+			data := []byte("immutable region")
+			sig, err := parsePGPSignature(tt.signer.sign(t, pgpSigBinary, data, pgpCreationTime(tt.signed)))
+			require.NoError(t, err)
+			err = sig.verify(keys[0], data)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// pgpTestKey is an RSA key to build transferable public keys and signatures with.
+type pgpTestKey struct {
+	priv *rsa.PrivateKey
+	key  *pgpPublicKey
+}
+
+func newPGPTestKey(t *testing.T, created uint32) pgpTestKey {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	body := binary.BigEndian.AppendUint32([]byte{4}, created)
+	body = append(body, pgpPubKeyRSA)
+	body = appendMPI(body, priv.N.Bytes())
+	body = appendMPI(body, big.NewInt(int64(priv.E)).Bytes())
+	key, err := parsePGPPublicKey(body)
+	require.NoError(t, err)
+	return pgpTestKey{priv: priv, key: key}
+}
+
+// sign returns a version 4 signature packet over data with the given hashed
+// subpackets.
+func (k pgpTestKey) sign(t *testing.T, sigType uint8, data []byte, subpackets ...[]byte) []byte {
+	t.Helper()
+	hashed := []byte{4, sigType, pgpPubKeyRSA, PGPHASHALGO_SHA256, 0, 0}
+	for _, sub := range subpackets {
+		hashed = append(append(hashed, byte(len(sub))), sub...)
+	}
+	binary.BigEndian.PutUint16(hashed[4:], uint16(len(hashed)-6))
+
+	h := sha256.New()
+	h.Write(data)
+	h.Write(hashed)
+	h.Write([]byte{4, 0xff})
+	h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(hashed))))
+	digest := h.Sum(nil)
+	s, err := rsa.SignPKCS1v15(nil, k.priv, crypto.SHA256, digest)
+	require.NoError(t, err)
+
+	body := append(hashed, 0, 10, 9, pgpSubpacketIssuer)
+	body = binary.BigEndian.AppendUint64(body, k.key.keyID)
+	body = append(body, digest[:2]...)
+	return pgpTestPacket(pgpTagSignature, appendMPI(body, s))
+}
+
+func pgpCreationTime(t uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte{pgpSubpacketCreationTime}, t)
+}
+
+// pgpTestPacket returns a new format packet with a five-octet length.
+func pgpTestPacket(tag uint8, body []byte) []byte {
+	packet := binary.BigEndian.AppendUint32([]byte{0xc0 | tag, 255}, uint32(len(body)))
+	return append(packet, body...)
+}
+
+func appendMPI(b, v []byte) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(new(big.Int).SetBytes(v).BitLen()))
+	return append(b, bytes.TrimLeft(v, "\x00")...)
+}
@@ -0,0 +1,48 @@
+package sqlite3
+
+import (
+	"io"
+	"io/fs"
+	"time"
+)
+
+const readerAtFSName = "rpmdb.sqlite"
+
+// readerAtFS exposes an io.ReaderAt as a filesystem holding a single file, so that
+// it can be served to SQLite through a VFS.
+type readerAtFS struct {
+	r    io.ReaderAt
+	size int64
+}
+
+func (f *readerAtFS) Open(name string) (fs.File, error) {
+	if name != readerAtFSName {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return &readerAtFile{
+		SectionReader: io.NewSectionReader(f.r, 0, f.size),
+	}, nil
+}
+
+type readerAtFile struct {
+	*io.SectionReader
+}
+
+func (f *readerAtFile) Stat() (fs.FileInfo, error) {
+	return readerAtFileInfo{size: f.Size()}, nil
+}
+
+func (f *readerAtFile) Close() error {
+	return nil
+}
+
+type readerAtFileInfo struct {
+	size int64
+}
+
+func (fi readerAtFileInfo) Name() string       { return readerAtFSName }
+func (fi readerAtFileInfo) Size() int64        { return fi.size }
+func (fi readerAtFileInfo) Mode() fs.FileMode  { return 0o444 }
+func (fi readerAtFileInfo) ModTime() time.Time { return time.Time{} }
+func (fi readerAtFileInfo) IsDir() bool        { return false }
+func (fi readerAtFileInfo) Sys() any           { return nil }
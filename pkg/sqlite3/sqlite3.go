@@ -2,17 +2,22 @@ package sqlite3
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/binary"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 
 	dbi "github.com/knqyf263/go-rpmdb/pkg/db"
 	"golang.org/x/xerrors"
+	"modernc.org/sqlite/vfs"
 )
 
 type SQLite3 struct {
 	*sql.DB
+	vfs *vfs.FS
 }
 
 var (
@@ -22,6 +27,11 @@ var (
 )
 
 func Open(path string) (*SQLite3, error) {
+	return OpenContext(context.Background(), path)
+}
+
+// OpenContext is like Open, but establishes the connection within the deadline of ctx.
+func OpenContext(ctx context.Context, path string) (*SQLite3, error) {
 	file, err := os.Open(path)
 	if err != nil {
 		return nil, err
@@ -42,49 +52,161 @@ func Open(path string) (*SQLite3, error) {
 	if err != nil {
 		return nil, xerrors.Errorf("failed to open sqlite3: %w", err)
 	}
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, xerrors.Errorf("failed to connect sqlite3: %w", err)
+	}
+
+	return &SQLite3{DB: db}, nil
+}
+
+// OpenFS opens the SQLite3 database stored at name in fsys, without requiring it
+// to exist on the local filesystem.
+func OpenFS(fsys fs.FS, name string) (*SQLite3, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	b := make([]byte, 16)
+	if _, err = io.ReadFull(file, b); err != nil {
+		return nil, xerrors.Errorf("binary read error: %w", err)
+	}
+
+	if !bytes.Equal(b, SQLite3_HeaderMagic) {
+		return nil, ErrorInvalidSQLite3
+	}
+
+	vfsName, vfsFS, err := vfs.New(fsys)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to register sqlite3 vfs: %w", err)
+	}
+
+	// open sqlite3 database in read-only mode
+	db, err := sql.Open("sqlite", fmt.Sprintf("file:%s?vfs=%s&mode=ro&immutable=1", name, vfsName))
+	if err != nil {
+		_ = vfsFS.Close()
+		return nil, xerrors.Errorf("failed to open sqlite3: %w", err)
+	}
+	if err = db.Ping(); err != nil {
+		_ = db.Close()
+		_ = vfsFS.Close()
+		return nil, xerrors.Errorf("failed to connect sqlite3: %w", err)
+	}
 
-	return &SQLite3{db}, nil
+	return &SQLite3{DB: db, vfs: vfsFS}, nil
+}
+
+// OpenReaderAt opens the SQLite3 database of the given size held in r.
+func OpenReaderAt(r io.ReaderAt, size int64) (*SQLite3, error) {
+	return OpenFS(&readerAtFS{r: r, size: size}, readerAtFSName)
+}
+
+func (db *SQLite3) Close() error {
+	err := db.DB.Close()
+	if db.vfs != nil {
+		if vfsErr := db.vfs.Close(); err == nil {
+			err = vfsErr
+		}
+	}
+	return err
 }
 
 func (db *SQLite3) Read() <-chan dbi.Entry {
+	return db.ReadContext(context.Background())
+}
+
+func (db *SQLite3) ReadContext(ctx context.Context) <-chan dbi.Entry {
 	entries := make(chan dbi.Entry)
 
 	go func() {
 		defer close(entries)
 
-		rows, err := db.Query("SELECT blob FROM Packages")
+		rows, err := db.QueryContext(ctx, "SELECT hnum, blob FROM Packages")
 		if err != nil {
-			entries <- dbi.Entry{
+			dbi.Send(ctx, entries, dbi.Entry{
 				Err: xerrors.Errorf("failed to SELECT query: %w", err),
-			}
-		}
-		if err := db.Close(); err != nil {
-			entries <- dbi.Entry{
-				Err: xerrors.Errorf("failed to close DB: %w", err),
-			}
+			})
 		}
-
 		if rows == nil {
-			entries <- dbi.Entry{
+			dbi.Send(ctx, entries, dbi.Entry{
 				Err: xerrors.Errorf("query failed to return rows: %w", err),
-			}
+			})
 			return
 		}
+		defer rows.Close()
 
 		for rows.Next() {
+			var hnum uint32
 			var blob string
-			if err := rows.Scan(&blob); err != nil {
-				entries <- dbi.Entry{
+			if err := rows.Scan(&hnum, &blob); err != nil {
+				if !dbi.Send(ctx, entries, dbi.Entry{
 					Err: xerrors.Errorf("failed to Scan Row: %w", err),
+				}) {
+					return
 				}
+				continue
 			}
 
-			entries <- dbi.Entry{
-				Value: []byte(blob),
-				Err:   nil,
+			if !dbi.Send(ctx, entries, dbi.Entry{
+				HeaderNum: hnum,
+				Value:     []byte(blob),
+				Err:       nil,
+			}) {
+				return
 			}
 		}
+		if err := rows.Err(); err != nil {
+			dbi.Send(ctx, entries, dbi.Entry{
+				Err: xerrors.Errorf("failed to iterate rows: %w", err),
+			})
+		}
 	}()
 
 	return entries
 }
+
+// LookupContext returns the header numbers stored under key in the index table,
+// e.g. SELECT hnum FROM Name WHERE key = 'bash'.
+func (db *SQLite3) LookupContext(ctx context.Context, index dbi.Index, key string) ([]uint32, error) {
+	var name string
+	err := db.QueryRowContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?", string(index)).Scan(&name)
+	if xerrors.Is(err, sql.ErrNoRows) {
+		return nil, xerrors.Errorf("%s: %w", index, dbi.ErrorIndexNotFound)
+	} else if err != nil {
+		return nil, xerrors.Errorf("failed to look up table %s: %w", index, err)
+	}
+
+	// the table name has been checked above and cannot be a bound parameter
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SELECT DISTINCT hnum FROM '%s' WHERE key = ? ORDER BY hnum", name), key)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to SELECT query: %w", err)
+	}
+	defer rows.Close()
+
+	var hnums []uint32
+	for rows.Next() {
+		var hnum uint32
+		if err := rows.Scan(&hnum); err != nil {
+			return nil, xerrors.Errorf("failed to Scan Row: %w", err)
+		}
+		hnums = append(hnums, hnum)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to iterate rows: %w", err)
+	}
+	return hnums, nil
+}
+
+// GetContext returns the blob of the Packages row with the given hnum.
+func (db *SQLite3) GetContext(ctx context.Context, hnum uint32) ([]byte, error) {
+	var blob []byte
+	err := db.QueryRowContext(ctx, "SELECT blob FROM Packages WHERE hnum = ?", hnum).Scan(&blob)
+	if xerrors.Is(err, sql.ErrNoRows) {
+		return nil, xerrors.Errorf("header %d: %w", hnum, dbi.ErrorHeaderNotFound)
+	} else if err != nil {
+		return nil, xerrors.Errorf("failed to SELECT query: %w", err)
+	}
+	return blob, nil
+}
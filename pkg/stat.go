@@ -0,0 +1,5 @@
+//go:build !linux
+
+package rpmdb
+
+func sysStat(st *fileStat, sys any) {}
@@ -0,0 +1,43 @@
+//go:build linux
+
+package rpmdb
+
+import (
+	"io/fs"
+	"path/filepath"
+	"syscall"
+)
+
+func sysStat(st *fileStat, sys any) {
+	s, ok := sys.(*syscall.Stat_t)
+	if !ok {
+		return
+	}
+	st.mode = uint16(s.Mode)
+	st.hasOwner = true
+	st.uid, st.gid = s.Uid, s.Gid
+	st.hasRdev = true
+	st.rdev = s.Rdev
+}
+
+func (r rootDirFS) Getxattr(name, attr string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "getxattr", Path: name, Err: fs.ErrInvalid}
+	}
+	p := filepath.Join(r.root, filepath.FromSlash(name))
+
+	size, err := syscall.Getxattr(p, attr, nil)
+	if err == syscall.ENODATA || err == syscall.ENOTSUP {
+		return nil, nil
+	} else if err != nil {
+		return nil, &fs.PathError{Op: "getxattr", Path: name, Err: err}
+	}
+	if size == 0 {
+		return nil, nil
+	}
+	value := make([]byte, size)
+	if size, err = syscall.Getxattr(p, attr, value); err != nil {
+		return nil, &fs.PathError{Op: "getxattr", Path: name, Err: err}
+	}
+	return value[:size], nil
+}
@@ -0,0 +1,671 @@
+package rpmdb
+
+import (
+	"archive/tar"
+	"bufio"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// VerifyAttrs is a set of file attributes rpm -V checks, as stored in
+// RPMTAG_FILEVERIFYFLAGS and reported in FileVerification.Result.
+type VerifyAttrs uint32
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/rpmvf.h
+const (
+	RPMVERIFY_NONE       VerifyAttrs = 0
+	RPMVERIFY_FILEDIGEST VerifyAttrs = 1 << 0 /*!< from %verify(filedigest) */
+	RPMVERIFY_FILESIZE   VerifyAttrs = 1 << 1 /*!< from %verify(size) */
+	RPMVERIFY_LINKTO     VerifyAttrs = 1 << 2 /*!< from %verify(link) */
+	RPMVERIFY_USER       VerifyAttrs = 1 << 3 /*!< from %verify(user) */
+	RPMVERIFY_GROUP      VerifyAttrs = 1 << 4 /*!< from %verify(group) */
+	RPMVERIFY_MTIME      VerifyAttrs = 1 << 5 /*!< from %verify(mtime) */
+	RPMVERIFY_MODE       VerifyAttrs = 1 << 6 /*!< from %verify(mode) */
+	RPMVERIFY_RDEV       VerifyAttrs = 1 << 7 /*!< from %verify(rdev) */
+	RPMVERIFY_CAPS       VerifyAttrs = 1 << 8 /*!< from %verify(caps) */
+
+	RPMVERIFY_READLINKFAIL VerifyAttrs = 1 << 28 /*!< readlink failed */
+	RPMVERIFY_READFAIL     VerifyAttrs = 1 << 29 /*!< file read failed */
+	RPMVERIFY_LSTATFAIL    VerifyAttrs = 1 << 30 /*!< lstat failed */
+
+	RPMVERIFY_FAILURES = RPMVERIFY_LSTATFAIL | RPMVERIFY_READFAIL | RPMVERIFY_READLINKFAIL
+	RPMVERIFY_ALL      = ^RPMVERIFY_NONE
+)
+
+// String formats the attributes like rpm -V, e.g. "S.5....T.". A dot is an
+// attribute that matches, a question mark one that could not be read.
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/verify.c#L254-L283
+func (a VerifyAttrs) String() string {
+	verify := func(attr VerifyAttrs, c byte, fail VerifyAttrs) byte {
+		switch {
+		case a&fail != 0:
+			return '?'
+		case a&attr != 0:
+			return c
+		}
+		return '.'
+	}
+	return string([]byte{
+		verify(RPMVERIFY_FILESIZE, 'S', 0),
+		verify(RPMVERIFY_MODE, 'M', 0),
+		verify(RPMVERIFY_FILEDIGEST, '5', RPMVERIFY_READFAIL),
+		verify(RPMVERIFY_RDEV, 'D', 0),
+		verify(RPMVERIFY_LINKTO, 'L', RPMVERIFY_READLINKFAIL),
+		verify(RPMVERIFY_USER, 'U', 0),
+		verify(RPMVERIFY_GROUP, 'G', 0),
+		verify(RPMVERIFY_MTIME, 'T', 0),
+		verify(RPMVERIFY_CAPS, 'P', 0),
+	})
+}
+
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/rpmfiles.h#L83-L93
+const (
+	RPMFILE_STATE_MISSING      int8 = -1 /* used for unavailable data */
+	RPMFILE_STATE_NORMAL       int8 = 0
+	RPMFILE_STATE_REPLACED     int8 = 1
+	RPMFILE_STATE_NOTINSTALLED int8 = 2
+	RPMFILE_STATE_NETSHARED    int8 = 3
+	RPMFILE_STATE_WRONGCOLOR   int8 = 4
+)
+
+// VerifyStatus is the outcome of verifying a file.
+type VerifyStatus int
+
+const (
+	VerifyOK       VerifyStatus = iota // the file matches the rpmdb
+	VerifyModified                     // some attributes differ, see FileVerification.Result
+	VerifyMissing                      // the file does not exist
+	VerifyExtra                        // the file is in a packaged directory but owned by no package
+)
+
+func (s VerifyStatus) String() string {
+	switch s {
+	case VerifyOK:
+		return "ok"
+	case VerifyModified:
+		return "modified"
+	case VerifyMissing:
+		return "missing"
+	case VerifyExtra:
+		return "extra"
+	default:
+		return "unknown"
+	}
+}
+
+// VerifyOptions selects what is verified, like the options of rpm -V. The zero
+// value verifies what rpm -V does by default.
+type VerifyOptions struct {
+	NoConfig bool        // skip %config files, like --noconfig
+	NoDocs   bool        // skip %doc files, like --nodocs
+	NoGhost  bool        // skip %ghost files, like --noghost
+	Omit     VerifyAttrs // attributes not to check, e.g. RPMVERIFY_MTIME for --nomtime
+
+	// Extra makes VerifyAll report the files in packaged directories that no
+	// package owns, which rpm -V does not do.
+	Extra bool
+
+	// Shared reports whether several installed packages own the file at path. A
+	// differing modification time of such a file is not reported, like rpm -V
+	// does. VerifyAll sets it from the database if it is nil, for Verify it can be
+	// answered with RpmDB.WhatOwns.
+	Shared func(path string) bool
+}
+
+// skips reports whether files with flags are left out.
+func (o VerifyOptions) skips(flags FileFlags) bool {
+	return o.NoConfig && int32(flags)&RPMFILE_CONFIG != 0 ||
+		o.NoDocs && int32(flags)&RPMFILE_DOC != 0 ||
+		o.NoGhost && int32(flags)&RPMFILE_GHOST != 0
+}
+
+// FileVerification is the result of verifying a file, like a line of rpm -Vv.
+type FileVerification struct {
+	Package *PackageInfo // nil for an extra file
+	File    FileInfo     // only Path is set for an extra file
+	Status  VerifyStatus
+	Result  VerifyAttrs // the attributes that differ
+	Err     error       // why the file is missing or could not be read
+}
+
+// String formats the result like rpm -V, e.g. "S.5....T.  c /etc/foo.conf".
+func (v FileVerification) String() string {
+	attr := ' '
+	if flags := v.File.Flags.String(); flags != "" {
+		attr = rune(flags[0])
+	}
+	switch v.Status {
+	case VerifyMissing:
+		s := fmt.Sprintf("missing   %c %s", attr, v.File.Path)
+		if v.Err != nil && !xerrors.Is(v.Err, fs.ErrNotExist) {
+			s += fmt.Sprintf(" (%s)", v.Err)
+		}
+		return s
+	case VerifyExtra:
+		return fmt.Sprintf("extra     %c %s", attr, v.File.Path)
+	default:
+		return fmt.Sprintf("%s  %c %s", v.Result, attr, v.File.Path)
+	}
+}
+
+// Failed reports whether rpm -V would print the file, i.e. it is not OK and not
+// a missing %config(missingok) or %ghost file.
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/verify.c#L307-L312
+func (v FileVerification) Failed() bool {
+	switch v.Status {
+	case VerifyOK:
+		return false
+	case VerifyMissing:
+		return int32(v.File.Flags)&(RPMFILE_MISSINGOK|RPMFILE_GHOST) == 0 || !xerrors.Is(v.Err, fs.ErrNotExist)
+	default:
+		return true
+	}
+}
+
+// Verify compares the installed files of the package with the root filesystem
+// root, like rpm -V --root does. Every file is returned, Failed tells which
+// ones rpm -V would print. Files shared with other packages are only known if
+// opts.Shared is set.
+//
+// Symbolic links are only detected if root provides ReadLink and Lstat methods,
+// like io/fs.ReadLinkFS. The owner, group and device numbers are only compared if
+// the fs.FileInfo of root tells them, as those of DirFS and archive/tar do, and
+// capabilities only if the security.capability extended attribute is available.
+func (p *PackageInfo) Verify(root fs.FS, opts VerifyOptions) ([]FileVerification, error) {
+	return newVerifier(root, opts).verifyPackage(p)
+}
+
+// VerifyAll verifies the files of all the installed packages, like rpm -Va.
+func (d *RpmDB) VerifyAll(root fs.FS, opts VerifyOptions) ([]FileVerification, error) {
+	return d.VerifyAllContext(context.Background(), root, opts)
+}
+
+// VerifyAllContext is like VerifyAll, but gives up once ctx is done.
+func (d *RpmDB) VerifyAllContext(ctx context.Context, root fs.FS, opts VerifyOptions) ([]FileVerification, error) {
+	pkgIdx, err := d.packageIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fileIdx, err := d.fileIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Shared == nil {
+		opts.Shared = func(p string) bool { return len(fileIdx.whatOwns(p)) > 1 }
+	}
+
+	v := newVerifier(root, opts)
+	var results []FileVerification
+	for _, pkg := range pkgIdx.packages {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		pkgResults, err := v.verifyPackage(pkg)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, pkgResults...)
+	}
+
+	if opts.Extra {
+		results = append(results, v.extraFiles(fileIdx)...)
+	}
+	return results, nil
+}
+
+// verifier holds what is shared between the packages verified against a root.
+type verifier struct {
+	root   fs.FS
+	opts   VerifyOptions
+	users  idNames
+	groups idNames
+}
+
+func newVerifier(root fs.FS, opts VerifyOptions) *verifier {
+	return &verifier{
+		root:   root,
+		opts:   opts,
+		users:  readIDNames(root, "/etc/passwd"),
+		groups: readIDNames(root, "/etc/group"),
+	}
+}
+
+func (v *verifier) verifyPackage(p *PackageInfo) ([]FileVerification, error) {
+	files, err := p.InstalledFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []FileVerification
+	for i, file := range files {
+		if v.opts.skips(file.Flags) {
+			continue
+		}
+		result := FileVerification{Package: p, File: file}
+		result.Result, result.Err = v.verifyFile(p, i, file)
+		// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/verify.c#L435-L443
+		if result.Result&RPMVERIFY_MTIME != 0 && v.opts.Shared != nil && v.opts.Shared(file.Path) {
+			result.Result &^= RPMVERIFY_MTIME
+		}
+		result.Status = verifyStatus(result.Result)
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func verifyStatus(result VerifyAttrs) VerifyStatus {
+	switch {
+	case result&RPMVERIFY_LSTATFAIL != 0:
+		return VerifyMissing
+	case result != 0:
+		return VerifyModified
+	default:
+		return VerifyOK
+	}
+}
+
+// verifyFile returns the attributes of the i-th file of the package that differ
+// from the file on disk.
+// source: https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/verify.c#L55-L250
+func (v *verifier) verifyFile(p *PackageInfo, i int, file FileInfo) (VerifyAttrs, error) {
+	attrs := file.VerifyFlags
+
+	state := RPMFILE_STATE_NORMAL
+	if i < len(p.FileStates) {
+		state = p.FileStates[i]
+	}
+	switch state {
+	case RPMFILE_STATE_NETSHARED, RPMFILE_STATE_NOTINSTALLED:
+		return 0, nil
+	case RPMFILE_STATE_REPLACED:
+		// only the existence of a replaced file can be verified
+		attrs = RPMVERIFY_LSTATFAIL
+	case RPMFILE_STATE_WRONGCOLOR:
+		// the file of the other color is installed, verify what they share
+		attrs &^= RPMVERIFY_FILEDIGEST | RPMVERIFY_FILESIZE | RPMVERIFY_MTIME | RPMVERIFY_RDEV
+	}
+
+	name, st, err := v.lstat(file.Path)
+	if err != nil {
+		return RPMVERIFY_LSTATFAIL, err
+	}
+
+	// a directory replaced with a symbolic link to a directory is verified as the
+	// target, if the link is owned by root or by the owner of the target
+	if file.Mode&fileTypeMask == fileTypeDir && st.mode&fileTypeMask == fileTypeSymlink {
+		if target, err := resolvePath(v.root, name); err == nil {
+			if _, dst, err := v.lstatName(target); err == nil && dst.mode&fileTypeMask == fileTypeDir &&
+				(st.uid == 0 || st.uid == dst.uid) {
+				name, st = target, dst
+			}
+		}
+	}
+
+	// not all attributes of non-regular files can be verified
+	switch st.mode & fileTypeMask {
+	case fileTypeDir, fileTypeFIFO, fileTypeChar, fileTypeBlock:
+		attrs &^= RPMVERIFY_FILEDIGEST | RPMVERIFY_FILESIZE | RPMVERIFY_MTIME | RPMVERIFY_LINKTO | RPMVERIFY_CAPS
+	case fileTypeSymlink:
+		attrs &^= RPMVERIFY_FILEDIGEST | RPMVERIFY_MTIME | RPMVERIFY_MODE | RPMVERIFY_CAPS
+	default:
+		attrs &^= RPMVERIFY_LINKTO
+	}
+	// content checks of %ghost files are meaningless
+	ghost := int32(file.Flags)&RPMFILE_GHOST != 0
+	if ghost {
+		attrs &^= RPMVERIFY_FILEDIGEST | RPMVERIFY_FILESIZE | RPMVERIFY_MTIME | RPMVERIFY_LINKTO
+	}
+	attrs &^= v.opts.Omit | RPMVERIFY_FAILURES
+
+	var result VerifyAttrs
+	var resultErr error
+	if attrs&RPMVERIFY_FILEDIGEST != 0 {
+		digest, err := v.digest(name, p.DigestAlgorithm)
+		if err != nil {
+			result |= RPMVERIFY_READFAIL | RPMVERIFY_FILEDIGEST
+			resultErr = err
+		} else if digest != file.Digest {
+			result |= RPMVERIFY_FILEDIGEST
+		}
+	}
+
+	// the size of a link is the length of its target, which not every
+	// fs.FileInfo reports, e.g. that of a tar header
+	size := st.Size()
+	if st.mode&fileTypeMask == fileTypeSymlink && attrs&(RPMVERIFY_LINKTO|RPMVERIFY_FILESIZE) != 0 {
+		target, err := v.readLink(name)
+		if err != nil {
+			if attrs&RPMVERIFY_LINKTO != 0 {
+				result |= RPMVERIFY_READLINKFAIL | RPMVERIFY_LINKTO
+				if resultErr == nil {
+					resultErr = err
+				}
+			}
+		} else {
+			size = int64(len(target))
+			if attrs&RPMVERIFY_LINKTO != 0 && target != file.LinkTo {
+				result |= RPMVERIFY_LINKTO
+			}
+		}
+	}
+
+	if attrs&RPMVERIFY_FILESIZE != 0 && size != file.Size {
+		result |= RPMVERIFY_FILESIZE
+	}
+
+	if attrs&RPMVERIFY_MODE != 0 {
+		metamode, filemode := file.Mode, st.mode
+		// comparing the type of %ghost files is meaningless, but perms are OK
+		if ghost {
+			metamode &^= fileTypeMask
+			filemode &^= fileTypeMask
+		}
+		if metamode != filemode {
+			result |= RPMVERIFY_MODE
+		}
+	}
+
+	if attrs&RPMVERIFY_RDEV != 0 {
+		ftype, stype := file.Mode&fileTypeMask, st.mode&fileTypeMask
+		if (ftype == fileTypeChar) != (stype == fileTypeChar) || (ftype == fileTypeBlock) != (stype == fileTypeBlock) {
+			result |= RPMVERIFY_RDEV
+		} else if (ftype == fileTypeChar || ftype == fileTypeBlock) && st.hasRdev {
+			if uint16(st.rdev) != file.RDev {
+				result |= RPMVERIFY_RDEV
+			}
+		}
+	}
+
+	if attrs&RPMVERIFY_CAPS != 0 && st.hasCaps {
+		// a capability set failing to parse is empty, as in rpm
+		want, _ := parseCapsText(file.Caps)
+		got, _ := parseVFSCaps(st.caps)
+		if want != got {
+			result |= RPMVERIFY_CAPS
+		}
+	}
+
+	if attrs&RPMVERIFY_MTIME != 0 && st.ModTime().Unix() != int64(file.MTime) {
+		result |= RPMVERIFY_MTIME
+	}
+
+	if attrs&RPMVERIFY_USER != 0 && st.hasOwner && !v.users.match(file.Username, st.uid, st.uname) {
+		result |= RPMVERIFY_USER
+	}
+	if attrs&RPMVERIFY_GROUP != 0 && st.hasOwner && !v.groups.match(file.Groupname, st.gid, st.gname) {
+		result |= RPMVERIFY_GROUP
+	}
+	return result, resultErr
+}
+
+// lstat returns the name within the root of the absolute path p and its
+// attributes. Symbolic links in the directory part are followed, the last
+// element is not.
+func (v *verifier) lstat(p string) (string, fileStat, error) {
+	p = path.Clean("/" + p)
+	name := "."
+	if p != "/" {
+		dir, err := resolvePath(v.root, path.Dir(p))
+		if err != nil {
+			return "", fileStat{}, err
+		}
+		name = path.Join(dir, path.Base(p))
+	}
+	return v.lstatName(name)
+}
+
+func (v *verifier) lstatName(name string) (string, fileStat, error) {
+	var fi fs.FileInfo
+	var err error
+	if lfs, ok := v.root.(readLinkFS); ok {
+		fi, err = lfs.Lstat(name)
+	} else {
+		fi, err = fs.Stat(v.root, name)
+	}
+	if err != nil {
+		return "", fileStat{}, err
+	}
+	return name, newFileStat(v.root, name, fi), nil
+}
+
+// readLink returns the target of the named link. A filesystem that reports a
+// link without being able to read it, e.g. from its Stat method, fails.
+func (v *verifier) readLink(name string) (string, error) {
+	lfs, ok := v.root.(readLinkFS)
+	if !ok {
+		return "", xerrors.Errorf("%s: the filesystem cannot read links", name)
+	}
+	return lfs.ReadLink(name)
+}
+
+// digest returns the hex encoded digest of the content of the named file.
+func (v *verifier) digest(name string, algo DigestAlgorithm) (string, error) {
+	var h hash.Hash
+	switch algo {
+	case 0, PGPHASHALGO_MD5:
+		// packages without RPMTAG_FILEDIGESTALGO use MD5
+		h = md5.New()
+	case PGPHASHALGO_SHA1:
+		h = sha1.New()
+	case PGPHASHALGO_SHA224:
+		h = sha256.New224()
+	case PGPHASHALGO_SHA256:
+		h = sha256.New()
+	case PGPHASHALGO_SHA384:
+		h = sha512.New384()
+	case PGPHASHALGO_SHA512:
+		h = sha512.New()
+	default:
+		return "", xerrors.Errorf("unsupported digest algorithm: %s", algo)
+	}
+
+	f, err := v.root.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// extraFiles returns the entries of the packaged directories that no package
+// owns. The content of unowned directories is not listed.
+func (v *verifier) extraFiles(idx *fileIndex) []FileVerification {
+	var dirs []string
+	for p, owners := range idx.owners {
+		for _, owner := range owners {
+			if owner.File.Mode&fileTypeMask == fileTypeDir {
+				dirs = append(dirs, p)
+				break
+			}
+		}
+	}
+	sort.Strings(dirs)
+
+	var results []FileVerification
+	for _, dir := range dirs {
+		name, err := resolvePath(v.root, dir)
+		if err != nil {
+			continue
+		}
+		entries, err := fs.ReadDir(v.root, name)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			p := path.Join(dir, entry.Name())
+			if len(idx.whatOwns(p)) > 0 {
+				continue
+			}
+			results = append(results, FileVerification{File: FileInfo{Path: p}, Status: VerifyExtra})
+		}
+	}
+	return results
+}
+
+// xattrFS is implemented by filesystems that can read extended attributes.
+// Getxattr returns nil without an error if the file does not have attr.
+type xattrFS interface {
+	fs.FS
+	Getxattr(name, attr string) ([]byte, error)
+}
+
+// paxCapability is the PAX record GNU tar stores file capabilities in.
+const paxCapability = "SCHILY.xattr.security.capability"
+
+// fileStat is what rpm -V looks at in struct stat.
+type fileStat struct {
+	fs.FileInfo
+	mode uint16 // st_mode
+
+	hasOwner     bool
+	uid, gid     uint32
+	uname, gname string // if the filesystem records names, like tar
+
+	hasRdev bool
+	rdev    uint64
+
+	hasCaps bool
+	caps    []byte // security.capability
+}
+
+func newFileStat(fsys fs.FS, name string, fi fs.FileInfo) fileStat {
+	st := fileStat{FileInfo: fi, mode: unixMode(fi.Mode())}
+	switch sys := fi.Sys().(type) {
+	case *tar.Header:
+		st.hasOwner = true
+		st.uid, st.gid = uint32(sys.Uid), uint32(sys.Gid)
+		st.uname, st.gname = sys.Uname, sys.Gname
+		st.hasRdev = true
+		st.rdev = uint64(sys.Devmajor)<<8 | uint64(sys.Devminor)&0xff
+		st.hasCaps = true
+		st.caps = []byte(sys.PAXRecords[paxCapability])
+	default:
+		sysStat(&st, sys)
+	}
+
+	if xfs, ok := fsys.(xattrFS); ok && !st.hasCaps && st.mode&fileTypeMask == fileTypeRegular {
+		if caps, err := xfs.Getxattr(name, "security.capability"); err == nil {
+			st.hasCaps, st.caps = true, caps
+		}
+	}
+	return st
+}
+
+// unixMode converts m to st_mode.
+func unixMode(m fs.FileMode) uint16 {
+	mode := uint16(m.Perm())
+	if m&fs.ModeSetuid != 0 {
+		mode |= 04000
+	}
+	if m&fs.ModeSetgid != 0 {
+		mode |= 02000
+	}
+	if m&fs.ModeSticky != 0 {
+		mode |= 01000
+	}
+
+	switch {
+	case m&fs.ModeDir != 0:
+		mode |= fileTypeDir
+	case m&fs.ModeSymlink != 0:
+		mode |= fileTypeSymlink
+	case m&fs.ModeNamedPipe != 0:
+		mode |= fileTypeFIFO
+	case m&fs.ModeSocket != 0:
+		mode |= fileTypeSocket
+	case m&fs.ModeCharDevice != 0:
+		mode |= fileTypeChar
+	case m&fs.ModeDevice != 0:
+		mode |= fileTypeBlock
+	case m&fs.ModeIrregular == 0:
+		mode |= fileTypeRegular
+	}
+	return mode
+}
+
+// idNames maps user or group IDs to names and back, from /etc/passwd or
+// /etc/group of the root.
+type idNames struct {
+	names map[uint32]string
+	ids   map[string]uint32
+}
+
+func readIDNames(fsys fs.FS, p string) idNames {
+	n := idNames{
+		names: make(map[uint32]string),
+		ids:   make(map[string]uint32),
+	}
+	name, err := resolvePath(fsys, p)
+	if err != nil {
+		return n
+	}
+	f, err := fsys.Open(name)
+	if err != nil {
+		return n
+	}
+	defer f.Close()
+
+	// name:password:ID:...; the first entry wins, as with getpwuid
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		if len(fields) < 3 || fields[0] == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			continue
+		}
+		if _, ok := n.names[uint32(id)]; !ok {
+			n.names[uint32(id)] = fields[0]
+		}
+		if _, ok := n.ids[fields[0]]; !ok {
+			n.ids[fields[0]] = uint32(id)
+		}
+	}
+	return n
+}
+
+// match reports whether the file owned by id, and by name if the filesystem
+// records it, is owned by want. rpm accepts a match of either the name or the ID.
+// ref. https://github.com/rpm-software-management/rpm/blob/rpm-4.16.0-release/lib/verify.c#L196-L233
+func (n idNames) match(want string, id uint32, name string) bool {
+	if want == "" {
+		return false
+	}
+	if name == "" {
+		// rpm knows root without looking it up
+		if id == 0 {
+			name = "root"
+		} else {
+			name = n.names[id]
+		}
+	}
+	if name == want {
+		return true
+	}
+
+	wantID, ok := n.ids[want]
+	if want == "root" {
+		wantID, ok = 0, true
+	}
+	return ok && wantID == id
+}
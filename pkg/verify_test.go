@@ -0,0 +1,422 @@
+package rpmdb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type verifyTestFile struct {
+	path    string
+	mode    uint16
+	flags   int32
+	data    string // the content of a regular file, the target of a link
+	missing bool   // not installed on disk
+}
+
+var verifyTestFiles = []verifyTestFile{
+	{path: "/etc/foo.conf", mode: 0100644, flags: RPMFILE_CONFIG | RPMFILE_NOREPLACE, data: "a=1\n"},
+	{path: "/etc/foo.d/optional.conf", mode: 0100644, flags: RPMFILE_CONFIG | RPMFILE_MISSINGOK, missing: true},
+	{path: "/usr/bin/foo", mode: 0100755, data: "#!/bin/sh\n"},
+	{path: "/usr/bin/foo-link", mode: 0120777, data: "foo"},
+	{path: "/usr/lib/foo", mode: 040755},
+	{path: "/usr/share/doc/foo/README", mode: 0100644, flags: RPMFILE_DOC, data: "readme\n"},
+	{path: "/var/log/foo.log", mode: 0100644, flags: RPMFILE_GHOST},
+}
+
+func TestPackageInfo_Verify(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   VerifyOptions
+		modify func(t *testing.T, root string)
+		want   []string // the failed files, like rpm -V
+	}{
+		{
+			name: "unmodified",
+		},
+		{
+			name: "modified config",
+			modify: func(t *testing.T, root string) {
+				require.NoError(t, os.WriteFile(filepath.Join(root, "etc/foo.conf"), []byte("a=2\n"), 0o644))
+			},
+			want: []string{"..5....T.  c /etc/foo.conf"},
+		},
+		{
+			name: "modified config with noconfig",
+			opts: VerifyOptions{NoConfig: true},
+			modify: func(t *testing.T, root string) {
+				require.NoError(t, os.WriteFile(filepath.Join(root, "etc/foo.conf"), []byte("a=2\n"), 0o644))
+			},
+		},
+		{
+			name: "modified config without mtime",
+			opts: VerifyOptions{Omit: RPMVERIFY_MTIME},
+			modify: func(t *testing.T, root string) {
+				require.NoError(t, os.WriteFile(filepath.Join(root, "etc/foo.conf"), []byte("a=2\n"), 0o644))
+			},
+			want: []string{"..5......  c /etc/foo.conf"},
+		},
+		{
+			name: "modified config shared with another package",
+			opts: VerifyOptions{Shared: func(p string) bool { return p == "/etc/foo.conf" }},
+			modify: func(t *testing.T, root string) {
+				require.NoError(t, os.WriteFile(filepath.Join(root, "etc/foo.conf"), []byte("a=2\n"), 0o644))
+			},
+			want: []string{"..5......  c /etc/foo.conf"},
+		},
+		{
+			name: "size and mode",
+			modify: func(t *testing.T, root string) {
+				p := filepath.Join(root, "usr/bin/foo")
+				require.NoError(t, os.WriteFile(p, []byte("#!/bin/bash\n"), 0o755))
+				require.NoError(t, os.Chmod(p, 0o700))
+			},
+			want: []string{"SM5....T.    /usr/bin/foo"},
+		},
+		{
+			name: "link target",
+			modify: func(t *testing.T, root string) {
+				p := filepath.Join(root, "usr/bin/foo-link")
+				require.NoError(t, os.Remove(p))
+				require.NoError(t, os.Symlink("bar", p))
+			},
+			want: []string{"....L....    /usr/bin/foo-link"},
+		},
+		{
+			name: "link target and size",
+			modify: func(t *testing.T, root string) {
+				p := filepath.Join(root, "usr/bin/foo-link")
+				require.NoError(t, os.Remove(p))
+				require.NoError(t, os.Symlink("foobar", p))
+			},
+			want: []string{"S...L....    /usr/bin/foo-link"},
+		},
+		{
+			name: "directory replaced with a file",
+			modify: func(t *testing.T, root string) {
+				p := filepath.Join(root, "usr/lib/foo")
+				require.NoError(t, os.Remove(p))
+				require.NoError(t, os.WriteFile(p, nil, 0o755))
+			},
+			// the content of the file is checked against the directory entry
+			want: []string{".M5....T.    /usr/lib/foo"},
+		},
+		{
+			name: "missing doc",
+			modify: func(t *testing.T, root string) {
+				require.NoError(t, os.Remove(filepath.Join(root, "usr/share/doc/foo/README")))
+			},
+			want: []string{"missing   d /usr/share/doc/foo/README"},
+		},
+		{
+			name: "missing doc with nodocs",
+			opts: VerifyOptions{NoDocs: true},
+			modify: func(t *testing.T, root string) {
+				require.NoError(t, os.Remove(filepath.Join(root, "usr/share/doc/foo/README")))
+			},
+		},
+		{
+			name: "ghost",
+			modify: func(t *testing.T, root string) {
+				// only the permissions and the owner of a ghost are checked
+				p := filepath.Join(root, "var/log/foo.log")
+				require.NoError(t, os.WriteFile(p, []byte("log\n"), 0o644))
+				require.NoError(t, os.Chmod(p, 0o600))
+			},
+			want: []string{".M.......  g /var/log/foo.log"},
+		},
+		{
+			name: "missing ghost",
+			modify: func(t *testing.T, root string) {
+				require.NoError(t, os.Remove(filepath.Join(root, "var/log/foo.log")))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, pkg := verifyTestRoot(t)
+			if tt.modify != nil {
+				tt.modify(t, root)
+			}
+
+			results, err := pkg.Verify(DirFS(root), tt.opts)
+			require.NoError(t, err)
+
+			var got []string
+			for _, result := range results {
+				assert.Equal(t, pkg, result.Package)
+				if result.Failed() {
+					got = append(got, result.String())
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// linkStatFS reports every file as a symbolic link from its Stat method,
+// without being able to read links.
+type linkStatFS struct{}
+
+func (linkStatFS) Open(name string) (fs.File, error) {
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (linkStatFS) Stat(name string) (fs.FileInfo, error) {
+	return linkInfo(path.Base(name)), nil
+}
+
+type linkInfo string
+
+func (i linkInfo) Name() string       { return string(i) }
+func (i linkInfo) Size() int64        { return 3 }
+func (i linkInfo) Mode() fs.FileMode  { return fs.ModeSymlink | 0o777 }
+func (i linkInfo) ModTime() time.Time { return time.Time{} }
+func (i linkInfo) IsDir() bool        { return false }
+func (i linkInfo) Sys() any           { return nil }
+
+func TestPackageInfo_Verify_linkWithoutReadLink(t *testing.T) {
+	pkg := &PackageInfo{
+		Name:        "foo",
+		BaseNames:   []string{"foo-link"},
+		DirIndexes:  []int32{0},
+		DirNames:    []string{"/usr/bin/"},
+		FileSizes:   []int32{3},
+		FileDigests: []string{""},
+		FileModes:   []uint16{0120777},
+		FileFlags:   []int32{0},
+		UserNames:   []string{"root"},
+		GroupNames:  []string{"root"},
+		FileLinkTos: []string{"foo"},
+	}
+
+	results, err := pkg.Verify(linkStatFS{}, VerifyOptions{})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "....?....    /usr/bin/foo-link", results[0].String())
+	assert.Error(t, results[0].Err)
+}
+
+func TestRpmDB_VerifyAll(t *testing.T) {
+	db, err := Open("testdata/cbl-mariner-2.0/rpmdb.sqlite")
+	require.NoError(t, err)
+	defer db.Close()
+
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "usr/bin"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "usr/bin/unowned"), nil, 0o755))
+
+	results, err := db.VerifyAll(DirFS(root), VerifyOptions{Extra: true})
+	require.NoError(t, err)
+
+	var extra, found []string
+	var missing int
+	for _, result := range results {
+		switch result.Status {
+		case VerifyExtra:
+			assert.Nil(t, result.Package)
+			extra = append(extra, result.File.Path)
+		case VerifyMissing:
+			missing++
+		default:
+			found = append(found, result.File.Path)
+		}
+	}
+	assert.Equal(t, []string{"/usr/bin/unowned"}, extra)
+	assert.Subset(t, []string{"/", "/usr", "/usr/bin"}, found)
+	assert.Greater(t, missing, 1000)
+}
+
+func TestVerifyAttrs_String(t *testing.T) {
+	tests := []struct {
+		attrs VerifyAttrs
+		want  string
+	}{
+		{attrs: RPMVERIFY_NONE, want: "........."},
+		{attrs: RPMVERIFY_FILESIZE | RPMVERIFY_FILEDIGEST | RPMVERIFY_MTIME, want: "S.5....T."},
+		{attrs: RPMVERIFY_MODE | RPMVERIFY_RDEV | RPMVERIFY_USER | RPMVERIFY_GROUP | RPMVERIFY_CAPS, want: ".M.D.UG.P"},
+		{attrs: RPMVERIFY_READFAIL | RPMVERIFY_FILEDIGEST | RPMVERIFY_READLINKFAIL | RPMVERIFY_LINKTO, want: "..?.?...."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.attrs.String())
+		})
+	}
+}
+
+func Test_idNames_match(t *testing.T) {
+	names := idNames{
+		names: map[uint32]string{1000: "alice", 1001: "bob"},
+		ids:   map[string]uint32{"alice": 1000, "bob": 1001, "carol": 1000},
+	}
+	tests := []struct {
+		name   string
+		want   string
+		id     uint32
+		idName string
+		match  bool
+	}{
+		{name: "name", want: "alice", id: 1000, match: true},
+		{name: "ID of a duplicate user", want: "carol", id: 1000, match: true},
+		{name: "different user", want: "bob", id: 1000, match: false},
+		{name: "unknown user", want: "dave", id: 1000, match: false},
+		{name: "root", want: "root", id: 0, match: true},
+		{name: "name recorded by the filesystem", want: "dave", id: 1000, idName: "dave", match: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.match, names.match(tt.want, tt.id, tt.idName))
+		})
+	}
+}
+
+func Test_parseCapsText(t *testing.T) {
+	netRaw, netAdmin := uint64(1)<<13, uint64(1)<<12
+	tests := []struct {
+		text    string
+		want    fileCaps
+		wantErr bool
+	}{
+		{text: "", want: fileCaps{}},
+		{text: "=", want: fileCaps{}},
+		{text: "cap_net_raw=ep", want: fileCaps{effective: netRaw, permitted: netRaw}},
+		{text: "cap_net_admin,cap_net_raw+p", want: fileCaps{permitted: netAdmin | netRaw}},
+		{text: "cap_net_raw=eip cap_net_raw-i", want: fileCaps{effective: netRaw, permitted: netRaw}},
+		{text: "CAP_NET_RAW=p", want: fileCaps{permitted: netRaw}},
+		{text: "13=p", want: fileCaps{permitted: netRaw}},
+		{text: "cap_unknown=p", wantErr: true},
+		{text: "cap_net_raw+", wantErr: true},
+		{text: "cap_net_raw", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := parseCapsText(tt.text)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseVFSCaps(t *testing.T) {
+	netRaw := uint64(1) << 13
+	tests := []struct {
+		name    string
+		data    string
+		want    fileCaps
+		wantErr bool
+	}{
+		{name: "none", data: "", want: fileCaps{}},
+		{
+			// setcap cap_net_raw=ep
+			name: "v2 effective",
+			data: "0100000200200000000000000000000000000000",
+			want: fileCaps{effective: netRaw, permitted: netRaw},
+		},
+		{
+			// setcap cap_net_raw=p
+			name: "v2",
+			data: "0000000200200000000000000000000000000000",
+			want: fileCaps{permitted: netRaw},
+		},
+		{
+			name: "v3",
+			data: "000000030020000000000000000000000000000000000000",
+			want: fileCaps{permitted: netRaw},
+		},
+		{name: "unknown revision", data: "00000004", wantErr: true},
+		{name: "short", data: "0000000200200000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tt.data)
+			require.NoError(t, err)
+			got, err := parseVFSCaps(data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// verifyTestRoot installs verifyTestFiles into a new root and returns it along
+// with the package owning them.
+func verifyTestRoot(t *testing.T) (string, *PackageInfo) {
+	t.Helper()
+	root := t.TempDir()
+
+	user := "tester"
+	if os.Getuid() == 0 {
+		user = "root"
+	}
+	passwd := fmt.Sprintf("root:x:0:0:root:/root:/bin/sh\ntester:x:%d:%d::/:/bin/sh\n", os.Getuid(), os.Getgid())
+	group := fmt.Sprintf("root:x:0:\ntester:x:%d:\n", os.Getgid())
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "etc"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "etc/passwd"), []byte(passwd), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "etc/group"), []byte(group), 0o644))
+
+	mtime := time.Unix(1700000000, 0)
+	pkg := &PackageInfo{Name: "foo", DigestAlgorithm: PGPHASHALGO_SHA256}
+	dirIndexes := make(map[string]int32)
+	for _, f := range verifyTestFiles {
+		dir, base := path.Split(f.path)
+		if _, ok := dirIndexes[dir]; !ok {
+			dirIndexes[dir] = int32(len(pkg.DirNames))
+			pkg.DirNames = append(pkg.DirNames, dir)
+		}
+
+		var digest, linkTo string
+		var size int32
+		switch f.mode & fileTypeMask {
+		case fileTypeRegular:
+			sum := sha256.Sum256([]byte(f.data))
+			digest, size = hex.EncodeToString(sum[:]), int32(len(f.data))
+		case fileTypeSymlink:
+			linkTo, size = f.data, int32(len(f.data))
+		}
+		pkg.BaseNames = append(pkg.BaseNames, base)
+		pkg.DirIndexes = append(pkg.DirIndexes, dirIndexes[dir])
+		pkg.FileSizes = append(pkg.FileSizes, size)
+		pkg.FileDigests = append(pkg.FileDigests, digest)
+		pkg.FileModes = append(pkg.FileModes, f.mode)
+		pkg.FileFlags = append(pkg.FileFlags, f.flags)
+		pkg.UserNames = append(pkg.UserNames, user)
+		pkg.GroupNames = append(pkg.GroupNames, user)
+		pkg.FileLinkTos = append(pkg.FileLinkTos, linkTo)
+		pkg.FileMTimes = append(pkg.FileMTimes, uint32(mtime.Unix()))
+
+		if f.missing {
+			continue
+		}
+		p := filepath.Join(root, filepath.FromSlash(f.path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		switch f.mode & fileTypeMask {
+		case fileTypeDir:
+			require.NoError(t, os.Mkdir(p, os.FileMode(f.mode&0o777)))
+		case fileTypeSymlink:
+			if err := os.Symlink(f.data, p); err != nil {
+				t.Skipf("symlinks are not supported: %s", err)
+			}
+		default:
+			require.NoError(t, os.WriteFile(p, []byte(f.data), 0o600))
+			require.NoError(t, os.Chmod(p, os.FileMode(f.mode&0o777)))
+			require.NoError(t, os.Chtimes(p, mtime, mtime))
+		}
+	}
+	return root, pkg
+}